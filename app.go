@@ -2,7 +2,11 @@ package main
 
 import (
 	"D2Timers/control"
+	"D2Timers/events"
 	"D2Timers/i18n"
+	"D2Timers/journal"
+	"D2Timers/keymap"
+	"D2Timers/notify"
 	"D2Timers/timer"
 	"D2Timers/ui"
 	"context"
@@ -10,7 +14,10 @@ import (
 	"fmt"
 	"image/color"
 	"log"
+	"os"
+	"path/filepath"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -34,23 +41,53 @@ type AppManager struct {
 	cmdCh        chan control.Command
 	cmdCtx       context.Context
 	cmdCancel    context.CancelFunc
+	scheduler    *timer.Scheduler
 
 	autoButton  *widget.Button
 	stopButton  *widget.Button
 	startButton *widget.Button
 	resetButton *widget.Button
+	resetHold   *ui.HoldToConfirm
 
 	audioBuffers map[string]*beep.Buffer
 	speakerLock  sync.Mutex
 	content      embed.FS
 
-	turboMode bool
+	activeProfile string
+	profileSelect *widget.Select
+	profilesDir   string
 
-	turboCheck *widget.Check
+	notifyEnabled bool
+	notifyCheck   *widget.Check
+	iconPaths     map[string]string
+
+	profilingOverlay *ui.ProfilingOverlay
+
+	bus *events.Bus
+
+	webhookSink *events.WebhookSink
+	webhookStop chan struct{}
+	webhookURL  string
+
+	fileSink *events.FileSink
+	fileStop chan struct{}
+	filePath string
+
+	journalPath string
+
+	keymap *keymap.Keymap
 }
 
 func NewAppManager(content embed.FS) *AppManager {
-	a := &AppManager{audioBuffers: make(map[string]*beep.Buffer), content: content}
+	a := &AppManager{
+		audioBuffers:  make(map[string]*beep.Buffer),
+		content:       content,
+		scheduler:     timer.NewScheduler(),
+		bus:           events.NewBus(),
+		journalPath:   defaultJournalPath(),
+		notifyEnabled: true,
+		iconPaths:     make(map[string]string),
+	}
 	timer.LoadTimerConfigs(content)
 	log.Printf("Loaded %d timer configs.", len(timer.TimerConfigs))
 	a.loadAudioFiles()
@@ -64,6 +101,19 @@ func NewAppManager(content embed.FS) *AppManager {
 		a.allTimers = append(a.allTimers, t)
 	}
 
+	a.profilesDir = defaultProfilesDir()
+	timer.LoadProfiles(content, a.profilesDir)
+	if len(timer.Profiles) > 0 {
+		a.activeProfile = timer.Profiles[0].Name
+	}
+
+	km, err := keymap.Load(content, keymap.DefaultOverridePath())
+	if err != nil {
+		log.Printf("keymap: failed to load bindings, using built-in defaults: %v", err)
+		km = keymap.Default()
+	}
+	a.keymap = km
+
 	return a
 }
 
@@ -108,26 +158,128 @@ func (a *AppManager) AllTimers() []*timer.DotaTimer {
 	return a.allTimers
 }
 
+// Snapshot reports every timer's current state for control.Server's
+// "status" command, letting external tools (overlays, Stream Deck
+// plugins) poll without touching the Fyne window.
+func (a *AppManager) Snapshot() []control.TimerStatus {
+	statuses := make([]control.TimerStatus, 0, len(a.allTimers))
+	for _, t := range a.allTimers {
+		snap := t.GetSnapshot()
+		statuses = append(statuses, control.TimerStatus{
+			Name:      snap.Name,
+			State:     stateLabel(snap.State),
+			Mode:      modeLabel(snap.Mode),
+			Remaining: snap.Remaining,
+		})
+	}
+	return statuses
+}
+
+func stateLabel(s timer.TimerState) string {
+	switch s {
+	case timer.StateActiveAuto, timer.StateActiveManual:
+		return "active"
+	case timer.StatePaused:
+		return "paused"
+	case timer.StateUnconfigured:
+		return "unconfigured"
+	default:
+		return "inactive"
+	}
+}
+
+func modeLabel(m timer.TimerMode) string {
+	if m == timer.ModeManual {
+		return "manual"
+	}
+	return "auto"
+}
+
 func (a *AppManager) AddActiveTimer(t *timer.DotaTimer) {
 	a.activeLock.Lock()
-	defer a.activeLock.Unlock()
+	found := false
 	for _, at := range a.activeTimers {
 		if at == t {
-			return
+			found = true
+			break
 		}
 	}
-	a.activeTimers = append(a.activeTimers, t)
+	if !found {
+		a.activeTimers = append(a.activeTimers, t)
+	}
+	a.activeLock.Unlock()
+
+	// Always re-register with the scheduler: Start on an already-active
+	// timer (or a Resume) recomputes t.Deadline, so the pending entry must
+	// be replaced to track it.
+	a.scheduler.Cancel(t)
+	a.scheduleExpire(t)
 }
 
 func (a *AppManager) RemoveActiveTimer(t *timer.DotaTimer) {
 	a.activeLock.Lock()
-	defer a.activeLock.Unlock()
 	for i, at := range a.activeTimers {
 		if at == t {
 			a.activeTimers = append(a.activeTimers[:i], a.activeTimers[i+1:]...)
-			return
+			break
+		}
+	}
+	a.activeLock.Unlock()
+
+	a.scheduler.Cancel(t)
+}
+
+// scheduleExpire registers t's current Deadline with the scheduler. When
+// Expire reports the timer repeats (a recurring cycle such as Stack
+// Neutrals), it reschedules itself against the freshly computed Deadline.
+func (a *AppManager) scheduleExpire(t *timer.DotaTimer) {
+	a.scheduler.ScheduleAt(t, t.Deadline(), timer.TickExpire, func() {
+		if t.Expire(a) {
+			a.scheduleExpire(t)
 		}
+		if t.UI != nil {
+			t.UI.UpdateDisplay()
+		}
+	})
+}
+
+// RunScheduler drives the Scheduler's wake loop; call it from its own
+// goroutine for the lifetime of the window, analogous to the redraw loop
+// started alongside it in main.go.
+func (a *AppManager) RunScheduler(ctx context.Context) {
+	a.scheduler.Run(ctx)
+}
+
+// defaultJournalPath is the session journal's location: journal.txt next
+// to D2Timers' other per-user state, under the OS config directory.
+func defaultJournalPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = "."
+	}
+	return filepath.Join(dir, "D2Timers", "journal.txt")
+}
+
+// defaultProfilesDir is where user-saved timer profiles live, next to
+// D2Timers' other per-user state, under the OS config directory.
+func defaultProfilesDir() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = "."
 	}
+	return filepath.Join(dir, "D2Timers", "profiles")
+}
+
+// RunJournal subscribes the session journal to the event bus and keeps it
+// appending to disk for the remainder of ctx, analogous to RunScheduler's
+// goroutine-per-subsystem startup in main.go.
+func (a *AppManager) RunJournal(ctx context.Context) {
+	stop := make(chan struct{})
+	go func() {
+		<-ctx.Done()
+		close(stop)
+	}()
+	journal.NewSink(a.journalPath).Run(a.bus, stop)
 }
 
 func (a *AppManager) loadAudioFiles() {
@@ -205,6 +357,165 @@ func (a *AppManager) PlaySound(filename string) {
 	speaker.Play(b.Streamer(0, b.Len()))
 }
 
+// Notify fires a desktop notification for t's alert, unless muted
+// globally via the Notifications checkbox or for this specific timer via
+// its TimerConfig.Notify field.
+func (a *AppManager) Notify(t *timer.DotaTimer) {
+	if !a.notifyEnabled || !t.Notify {
+		return
+	}
+	notify.Send(t.Name, i18n.T("Timer expired"), a.iconPathFor(t.BackgroundImageName))
+}
+
+// iconPathFor extracts filename from the embedded asset FS to a cached
+// temp file and returns its path, since desktop notification backends
+// expect an on-disk icon rather than in-memory bytes. Returns "" (no
+// icon) if filename is empty or extraction fails.
+func (a *AppManager) iconPathFor(filename string) string {
+	if filename == "" {
+		return ""
+	}
+	if path, ok := a.iconPaths[filename]; ok {
+		return path
+	}
+
+	data, err := a.content.ReadFile(fmt.Sprintf("assets/%s", filename))
+	if err != nil {
+		log.Printf("notify: failed to read icon %s: %v", filename, err)
+		a.iconPaths[filename] = ""
+		return ""
+	}
+
+	tmp, err := os.CreateTemp("", "d2timers-icon-*-"+filepath.Base(filename))
+	if err != nil {
+		log.Printf("notify: failed to create temp icon file: %v", err)
+		a.iconPaths[filename] = ""
+		return ""
+	}
+	defer tmp.Close()
+	if _, err := tmp.Write(data); err != nil {
+		log.Printf("notify: failed to write temp icon file: %v", err)
+		a.iconPaths[filename] = ""
+		return ""
+	}
+
+	a.iconPaths[filename] = tmp.Name()
+	return tmp.Name()
+}
+
+// IsNotifyEnabled reports whether desktop notifications are currently
+// enabled globally.
+func (a *AppManager) IsNotifyEnabled() bool {
+	return a.notifyEnabled
+}
+
+// SetNotifyEnabled toggles desktop notifications globally, independent of
+// audio alerts.
+func (a *AppManager) SetNotifyEnabled(enabled bool) {
+	a.notifyEnabled = enabled
+}
+
+// SetNotifyCheck registers the footer's Notifications checkbox.
+func (a *AppManager) SetNotifyCheck(c *widget.Check) {
+	a.notifyCheck = c
+}
+
+// PublishEvent fans ev out to the event bus's subscribers (the webhook and
+// file sinks, if enabled).
+func (a *AppManager) PublishEvent(ev events.Event) {
+	a.bus.Publish(ev)
+}
+
+// Subscribe returns a channel receiving every event published after this
+// call, for consumers (e.g. the debuglink build) that only see AppManager
+// rather than being handed a.bus directly the way
+// journal.Sink/WebhookSink/FileSink are.
+func (a *AppManager) Subscribe() <-chan events.Event {
+	return a.bus.Subscribe()
+}
+
+// Unsubscribe removes ch from the event bus. Callers of Subscribe must
+// call this once done listening, or their channel leaks forever (see
+// events.Bus.Unsubscribe).
+func (a *AppManager) Unsubscribe(ch <-chan events.Event) {
+	a.bus.Unsubscribe(ch)
+}
+
+// EnableWebhookSink starts (or, called again, replaces) a WebhookSink
+// posting to url. Passing enable=false or an empty url stops any running
+// sink. Safe to call repeatedly from the settings dialog.
+func (a *AppManager) EnableWebhookSink(enable bool, url string) {
+	if a.webhookStop != nil {
+		close(a.webhookStop)
+		a.webhookStop = nil
+		a.webhookSink = nil
+	}
+	if !enable || url == "" {
+		return
+	}
+	a.webhookSink = events.NewWebhookSink(url)
+	a.webhookStop = make(chan struct{})
+	go a.webhookSink.Run(a.bus, a.webhookStop)
+}
+
+// EnableFileSink starts (or, called again, replaces) a FileSink appending
+// to path. Passing enable=false or an empty path stops any running sink.
+func (a *AppManager) EnableFileSink(enable bool, path string) {
+	if a.fileStop != nil {
+		close(a.fileStop)
+		a.fileStop = nil
+		if a.fileSink != nil {
+			a.fileSink.Close()
+			a.fileSink = nil
+		}
+	}
+	if !enable || path == "" {
+		return
+	}
+	sink, err := events.NewFileSink(path)
+	if err != nil {
+		log.Printf("events: failed to open file sink %s: %v", path, err)
+		return
+	}
+	a.fileSink = sink
+	a.fileStop = make(chan struct{})
+	go a.fileSink.Run(a.bus, a.fileStop)
+}
+
+// ShowSettingsDialog lets the user configure the external event sinks:
+// a webhook URL and/or a newline-delimited JSON log file that every
+// published timer event is sent to.
+func (a *AppManager) ShowSettingsDialog() {
+	webhookCheck := widget.NewCheck("", nil)
+	webhookCheck.Checked = a.webhookSink != nil
+	webhookEntry := widget.NewEntry()
+	webhookEntry.SetPlaceHolder("https://example.com/webhook")
+	webhookEntry.Text = a.webhookURL
+
+	fileCheck := widget.NewCheck("", nil)
+	fileCheck.Checked = a.fileSink != nil
+	fileEntry := widget.NewEntry()
+	fileEntry.SetPlaceHolder("events.jsonl")
+	fileEntry.Text = a.filePath
+
+	items := []*widget.FormItem{
+		widget.NewFormItem(i18n.T("Webhook enabled"), webhookCheck),
+		widget.NewFormItem(i18n.T("Webhook URL"), webhookEntry),
+		widget.NewFormItem(i18n.T("File log enabled"), fileCheck),
+		widget.NewFormItem(i18n.T("File log path"), fileEntry),
+	}
+
+	dialog.ShowForm(i18n.T("Settings"), i18n.T("Save"), i18n.T("Cancel"), items, func(ok bool) {
+		if !ok {
+			return
+		}
+		a.webhookURL = webhookEntry.Text
+		a.filePath = fileEntry.Text
+		a.EnableWebhookSink(webhookCheck.Checked, a.webhookURL)
+		a.EnableFileSink(fileCheck.Checked, a.filePath)
+	}, a.mainWindow)
+}
+
 func (a *AppManager) UpdateControlButtonState() {
 	isAnyActive := false
 	isAnyPaused := false
@@ -267,13 +578,13 @@ func (a *AppManager) UpdateControlButtonState() {
 				}
 			}
 
-			if a.turboCheck != nil {
+			if a.profileSelect != nil {
 				fyne.Do(func() {
-					a.turboCheck.SetChecked(a.turboMode)
+					a.profileSelect.SetSelected(a.activeProfile)
 					if allInitial {
-						a.turboCheck.Enable()
+						a.profileSelect.Enable()
 					} else {
-						a.turboCheck.Disable()
+						a.profileSelect.Disable()
 					}
 				})
 			}
@@ -281,43 +592,62 @@ func (a *AppManager) UpdateControlButtonState() {
 	})
 }
 
-func (a *AppManager) SetTurboCheck(c *widget.Check) {
-	a.turboCheck = c
+func (a *AppManager) SetProfileSelect(s *widget.Select) {
+	a.profileSelect = s
 }
 
-func (a *AppManager) IsTurboEnabled() bool {
-	return a.turboMode
+func (a *AppManager) SetProfilingOverlay(o *ui.ProfilingOverlay) {
+	a.profilingOverlay = o
 }
 
-func (a *AppManager) ToggleTurboMode(enable bool) error {
-	if enable {
-		for _, t := range a.allTimers {
-			st := t.GetState()
-			if st != timer.StateInactive && st != timer.StateUnconfigured {
-				fyne.Do(func() {
-					dialog.ShowInformation(i18n.T("Turbo Mode"), i18n.T("Turbo Mode can only be enabled when all timers are in their initial state."), a.mainWindow)
-				})
-				return fmt.Errorf("cannot enable turbo: not all timers in initial state")
-			}
-		}
+// ActiveTimerCount reports how many timers are currently counting down, for
+// the profiling overlay.
+func (a *AppManager) ActiveTimerCount() int {
+	a.activeLock.Lock()
+	defer a.activeLock.Unlock()
+	return len(a.activeTimers)
+}
+
+// ActiveProfileName returns the name of the profile currently applied to
+// every timer's durations.
+func (a *AppManager) ActiveProfileName() string {
+	return a.activeProfile
+}
+
+// AvailableProfileNames lists every profile that can be passed to
+// SetActiveProfile, for the profile combobox.
+func (a *AppManager) AvailableProfileNames() []string {
+	return timer.ProfileNames()
+}
+
+// SetActiveProfile switches every timer to profile name's Auto/Manual
+// durations. Like the old turbo toggle it replaced, this is only allowed
+// while every timer is in its initial state, since changing durations
+// mid-countdown would desync the running deadline.
+func (a *AppManager) SetActiveProfile(name string) error {
+	profile, ok := timer.FindProfile(name)
+	if !ok {
+		return fmt.Errorf("unknown profile: %s", name)
 	}
 
 	for _, t := range a.allTimers {
-		oNormal_Auto_Initial, oNormal_Auto_Repeat, oTurbo_Auto_Initial, oTurbo_Auto_Repeat, oNormal_Manual_Initial, oNormal_Manual_Repeat, oTurbo_Manual_Initial, oTurbo_Manual_Repeat := t.GetOriginals()
-		if enable {
-			if oTurbo_Auto_Initial != 0 {
-				t.SetNormal_Auto_InitialRepeat(oTurbo_Auto_Initial, oTurbo_Auto_Repeat)
-			}
-			if oTurbo_Manual_Initial != 0 {
-				t.SetNormal_Manual_InitialRepeat(oTurbo_Manual_Initial, oTurbo_Manual_Repeat)
-			}
-		} else {
-			t.SetNormal_Auto_InitialRepeat(oNormal_Auto_Initial, oNormal_Auto_Repeat)
-			t.SetNormal_Manual_InitialRepeat(oNormal_Manual_Initial, oNormal_Manual_Repeat)
+		st := t.GetState()
+		if st != timer.StateInactive && st != timer.StateUnconfigured {
+			fyne.Do(func() {
+				dialog.ShowInformation(i18n.T("Profiles"), i18n.T("Profiles can only be switched when all timers are in their initial state."), a.mainWindow)
+			})
+			return fmt.Errorf("cannot switch profile: not all timers in initial state")
 		}
 	}
 
-	a.turboMode = enable
+	profile.Apply(a, a.allTimers)
+	a.activeProfile = profile.Name
+
+	a.bus.Publish(events.Event{
+		Kind:    events.EvProfileChanged,
+		At:      time.Now(),
+		Profile: profile.Name,
+	})
 
 	fyne.Do(func() {
 		for _, t := range a.allTimers {
@@ -330,7 +660,88 @@ func (a *AppManager) ToggleTurboMode(enable bool) error {
 	return nil
 }
 
-func (a *AppManager) tick(ctx context.Context) {
+// ShowSaveProfileDialog captures every timer's current Auto/Manual
+// durations (and, for a Custom Timer-style entry, its current custom
+// duration) into a new profile written to the user's profiles
+// directory, so it's picked up by LoadProfiles on the next launch.
+func (a *AppManager) ShowSaveProfileDialog() {
+	nameEntry := widget.NewEntry()
+	nameEntry.SetPlaceHolder(i18n.T("Profile name"))
+
+	items := []*widget.FormItem{
+		widget.NewFormItem(i18n.T("Name"), nameEntry),
+	}
+
+	dialog.ShowForm(i18n.T("Save as profile..."), i18n.T("Save"), i18n.T("Cancel"), items, func(ok bool) {
+		if !ok || nameEntry.Text == "" {
+			return
+		}
+		if err := a.saveProfile(nameEntry.Text); err != nil {
+			dialog.ShowError(err, a.mainWindow)
+		}
+	}, a.mainWindow)
+}
+
+func (a *AppManager) saveProfile(name string) error {
+	p := &timer.Profile{Name: name, Timers: map[string]timer.TimerProfileValues{}}
+	for _, t := range a.allTimers {
+		p.Timers[t.Name] = timer.TimerProfileValues{
+			Auto_Initial:   t.Normal_Auto_Initial,
+			Auto_Repeat:    t.Normal_Auto_Repeat,
+			Manual_Initial: t.Normal_Manual_Initial,
+			Manual_Repeat:  t.Normal_Manual_Repeat,
+			CustomDuration: t.GetCustomDurationSec(),
+		}
+	}
+
+	if err := os.MkdirAll(a.profilesDir, 0755); err != nil {
+		return fmt.Errorf("creating profiles directory: %w", err)
+	}
+
+	data, err := yaml.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("encoding profile: %w", err)
+	}
+
+	path := filepath.Join(a.profilesDir, sanitizeProfileFilename(name)+".yaml")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing profile: %w", err)
+	}
+
+	timer.LoadProfiles(a.content, a.profilesDir)
+	a.activeProfile = name
+	if a.profileSelect != nil {
+		fyne.Do(func() {
+			a.profileSelect.Options = timer.ProfileNames()
+			a.profileSelect.SetSelected(name)
+		})
+	}
+
+	return nil
+}
+
+// sanitizeProfileFilename turns a user-entered profile name into a safe
+// file basename, so input like "../../etc/passwd" can't escape
+// profilesDir.
+func sanitizeProfileFilename(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == ' ':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// redraw refreshes every timer's display once a second. Expiry and
+// alerting are no longer driven from here: the Scheduler fires each active
+// timer's Expire exactly at its Deadline (see scheduleExpire), so this loop
+// only keeps the on-screen countdown moving. It still wakes once a second
+// and redraws every timer whether or not any timer is active.
+func (a *AppManager) redraw(ctx context.Context) {
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 
@@ -339,73 +750,136 @@ func (a *AppManager) tick(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			timersToAlert := make([]*timer.DotaTimer, 0)
-
-			a.activeLock.Lock()
-			activeCopy := make([]*timer.DotaTimer, len(a.activeTimers))
-			copy(activeCopy, a.activeTimers)
-			a.activeLock.Unlock()
-
-			for _, t := range activeCopy {
-				t.Tick(a)
-				if t.Remaining <= 0 {
-					timersToAlert = append(timersToAlert, t)
-				}
-			}
-
-			if len(timersToAlert) > 0 {
-				sort.Slice(timersToAlert, func(i, j int) bool {
-					return timersToAlert[i].Priority > timersToAlert[j].Priority
-				})
-
-				timersToAlert[0].Alert(a)
-			}
-
 			for _, t := range a.allTimers {
 				if t.UI != nil {
 					t.UI.UpdateDisplay()
 				}
 			}
+			if a.profilingOverlay != nil && a.profilingOverlay.ProfilingEnabled() {
+				fyne.Do(a.profilingOverlay.Refresh)
+			}
 		}
 	}
 }
 
-func (a *AppManager) HandleKeyRune(r rune) {
-	var index int = -1
+// BindKeymap wires the active keybinding table onto w, replacing the
+// old hardcoded HandleKeyRune switch.
+func (a *AppManager) BindKeymap(w fyne.Window) {
+	a.keymap.BindTo(a, w)
+}
 
-	switch r {
-	case ' ':
-		if !a.stopButton.Hidden {
-			a.stopButton.Tapped(&fyne.PointEvent{})
-		} else if !a.startButton.Hidden {
-			a.startButton.Tapped(&fyne.PointEvent{})
-		} else if !a.autoButton.Hidden {
-			a.autoButton.Tapped(&fyne.PointEvent{})
-		}
-	case 't', 'T':
-		if a.turboCheck != nil {
-			a.turboCheck.SetChecked(!a.turboCheck.Checked)
-		}
-	case 'r', 'R':
-		a.resetButton.Tapped(&fyne.PointEvent{})
-	case 'z', 'Z':
-		index = int(timer.TimerIndexStackNeutrals)
-	case 'x', 'X':
-		index = int(timer.TimerIndexPowerRunes)
-	case 'c', 'C':
-		index = int(timer.TimerIndexShrinesOfWisdom)
-	case 'v', 'V':
-		index = int(timer.TimerIndexCustomTimer)
-	}
-
-	if index >= 0 && index < len(a.allTimers) {
-		t := a.allTimers[index]
-		if uw, ok := t.UI.(*ui.TimerWidget); ok {
-			uw.GetCanvasObject().(*ui.TappableContainer).Tapped(&fyne.PointEvent{})
-		}
+// ToggleGlobalStartStop mirrors clicking whichever of Stop/Start/Auto is
+// currently visible. Bound to "space" by default.
+func (a *AppManager) ToggleGlobalStartStop() {
+	if !a.stopButton.Hidden {
+		a.stopButton.Tapped(&fyne.PointEvent{})
+	} else if !a.startButton.Hidden {
+		a.startButton.Tapped(&fyne.PointEvent{})
+	} else if !a.autoButton.Hidden {
+		a.autoButton.Tapped(&fyne.PointEvent{})
+	}
+}
+
+// ResetAll confirms the reset-all hold gesture, immediately resetting
+// every timer with no further confirmation. It is not bound to any key
+// by default, precisely because that would bypass the 800ms hold
+// HoldToConfirm enforces everywhere else (see keymap.defaultBindings);
+// it exists so a user can deliberately rebind it in their own
+// keybindings.yaml override, or so other callers that have already
+// gated on a confirmation of their own (e.g. a held key) can invoke it.
+func (a *AppManager) ResetAll() {
+	if a.resetHold != nil {
+		a.resetHold.Confirm()
 	}
 }
 
+// ToggleTurbo flips between the "Normal" and "Turbo" profiles, a
+// shortcut for the common case now that profiles generalize what used to
+// be a single turbo checkbox. Bound to "t" by default; no keybinding
+// action selects any other profile, which is done from the combobox.
+func (a *AppManager) ToggleTurbo() {
+	next := "Turbo"
+	if a.activeProfile == "Turbo" {
+		next = "Normal"
+	}
+	if _, ok := timer.FindProfile(next); !ok {
+		return
+	}
+	if err := a.SetActiveProfile(next); err != nil {
+		log.Printf("keymap: toggle_turbo: %v", err)
+	}
+}
+
+// ToggleProfiling flips the profiling overlay. Bound to "~" by default.
+func (a *AppManager) ToggleProfiling() {
+	if a.profilingOverlay != nil {
+		a.profilingOverlay.SetProfilingEnabled(!a.profilingOverlay.ProfilingEnabled())
+	}
+}
+
+// timerIndexBySymbol maps the "timer:<Symbol>" action identifiers used
+// by keybindings.yaml to the old TimerIndex enum, since this snapshot
+// has no real timers_config.yaml to confirm a timer's Name against.
+var timerIndexBySymbol = map[string]timer.TimerIndex{
+	"StackNeutrals":   timer.TimerIndexStackNeutrals,
+	"PowerRunes":      timer.TimerIndexPowerRunes,
+	"ShrinesOfWisdom": timer.TimerIndexShrinesOfWisdom,
+	"CustomTimer":     timer.TimerIndexCustomTimer,
+}
+
+// TriggerTimer invokes the primary-tap action (matching a mouse click)
+// for the timer identified by symbol, e.g. "StackNeutrals" from a
+// "timer:StackNeutrals" keybinding action.
+func (a *AppManager) TriggerTimer(symbol string) {
+	index, ok := timerIndexBySymbol[symbol]
+	if !ok {
+		log.Printf("keymap: unknown timer symbol %q", symbol)
+		return
+	}
+	if int(index) < 0 || int(index) >= len(a.allTimers) {
+		return
+	}
+	t := a.allTimers[index]
+	if uw, ok := t.UI.(*ui.TimerWidget); ok {
+		uw.GetCanvasObject().(*ui.HoldToConfirm).Tapped(&fyne.PointEvent{})
+	}
+}
+
+// ShowHelp opens the Help dialog. Bound to "show_help" by default (not
+// bound to a key out of the box, see assets/keybindings.yaml).
+func (a *AppManager) ShowHelp() {
+	a.ShowInfoDialog(i18n.T("Help"), "assets/timers_help.yaml", fyne.NewSize(500, 400))
+}
+
+// ShowHistory opens the History dialog. Bound to "show_history" by
+// default (not bound to a key out of the box, see assets/keybindings.yaml).
+func (a *AppManager) ShowHistory() {
+	a.ShowHistoryDialog()
+}
+
+// ShowKeybindingsDialog lists every active keybinding and which file (or
+// the built-in defaults) it was loaded from, so power users can check
+// or rebind hotkeys without recompiling.
+func (a *AppManager) ShowKeybindingsDialog() {
+	bindings := a.keymap.Bindings()
+
+	list := widget.NewList(
+		func() int { return len(bindings) },
+		func() fyne.CanvasObject { return widget.NewLabel("") },
+		func(id widget.ListItemID, o fyne.CanvasObject) {
+			b := bindings[id]
+			o.(*widget.Label).SetText(fmt.Sprintf("%-10s %s", b.Token, b.Action))
+		},
+	)
+
+	sourceLabel := widget.NewLabel(fmt.Sprintf("%s: %s", i18n.T("Source"), a.keymap.Source))
+
+	content := container.NewBorder(sourceLabel, nil, nil, nil, list)
+	content.Resize(fyne.NewSize(420, 420))
+
+	dialog.ShowCustom(i18n.T("Keybindings"), i18n.T("Close"), content, a.mainWindow)
+}
+
 func (a *AppManager) ShowInfoDialog(title, contentFile string, minSize fyne.Size) {
 	var contentText string
 	if contentFile == "assets/timers_help.yaml" {
@@ -439,6 +913,118 @@ func (a *AppManager) ShowInfoDialog(title, contentFile string, minSize fyne.Size
 	dialog.ShowCustom(title, i18n.T("Close"), scrollableContent, a.mainWindow)
 }
 
+// historyStatusLabels maps the History dialog's status selector options,
+// in display order, to the journal.Status they filter by.
+var historyStatusLabels = []struct {
+	label  string
+	status journal.Status
+}{
+	{"All", journal.StatusAll},
+	{"Active", journal.StatusActive},
+	{"Done", journal.StatusDone},
+}
+
+func historyStatusFor(label string) journal.Status {
+	for _, s := range historyStatusLabels {
+		if s.label == label {
+			return s.status
+		}
+	}
+	return journal.StatusAll
+}
+
+// ShowHistoryDialog displays the session journal as a scrollable,
+// filterable list: a text filter narrows entries by timer name, and a
+// status selector restricts the view to active, done, or all sessions.
+// Both are backed by journal.Log.Filter rather than a local reimplementation,
+// so the dialog can't silently drift from the package API it's built on.
+func (a *AppManager) ShowHistoryDialog() {
+	log, err := journal.Load(a.journalPath)
+	if err != nil {
+		dialog.ShowError(err, a.mainWindow)
+		return
+	}
+	log.SortByStart(false)
+
+	var visible []journal.Entry
+
+	list := widget.NewList(
+		func() int { return len(visible) },
+		func() fyne.CanvasObject { return widget.NewLabel("") },
+		func(id widget.ListItemID, o fyne.CanvasObject) {
+			o.(*widget.Label).SetText(formatHistoryEntry(visible[id]))
+		},
+	)
+
+	applyFilter := func(substr, statusLabel string) {
+		visible = log.Filter(substr, historyStatusFor(statusLabel))
+		list.Refresh()
+	}
+
+	var statusSelect *widget.Select
+
+	filterEntry := widget.NewEntry()
+	filterEntry.SetPlaceHolder(i18n.T("Filter by timer name"))
+	filterEntry.OnChanged = func(text string) {
+		applyFilter(text, statusSelect.Selected)
+	}
+
+	statusLabels := make([]string, len(historyStatusLabels))
+	for i, s := range historyStatusLabels {
+		statusLabels[i] = s.label
+	}
+	statusSelect = widget.NewSelect(statusLabels, func(selected string) {
+		applyFilter(filterEntry.Text, selected)
+	})
+	statusSelect.SetSelected(historyStatusLabels[0].label)
+
+	archiveButton := widget.NewButton(i18n.T("Archive completed"), func() {
+		if err := journal.Archive(a.journalPath); err != nil {
+			dialog.ShowError(err, a.mainWindow)
+			return
+		}
+		reloaded, err := journal.Load(a.journalPath)
+		if err != nil {
+			dialog.ShowError(err, a.mainWindow)
+			return
+		}
+		reloaded.SortByStart(false)
+		log = reloaded
+		applyFilter(filterEntry.Text, statusSelect.Selected)
+	})
+
+	controls := container.NewBorder(nil, nil, nil, container.NewHBox(statusSelect, archiveButton), filterEntry)
+	content := container.NewBorder(controls, nil, nil, nil, list)
+	content.Resize(fyne.NewSize(520, 420))
+
+	applyFilter("", historyStatusLabels[0].label)
+
+	dialog.ShowCustom(i18n.T("History"), i18n.T("Close"), content, a.mainWindow)
+}
+
+// formatHistoryEntry renders a journal.Entry as one line for the History
+// dialog's list.
+func formatHistoryEntry(e journal.Entry) string {
+	status := " "
+	if e.Done {
+		status = "x"
+	}
+	line := fmt.Sprintf("%s %s", status, e.At.Local().Format("2006-01-02 15:04:05"))
+	if e.Tag != "" {
+		line += " " + e.Tag
+	}
+
+	keys := make([]string, 0, len(e.Meta))
+	for k := range e.Meta {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		line += fmt.Sprintf("  %s:%s", k, e.Meta[k])
+	}
+	return line
+}
+
 func (a *AppManager) SetAutoButton(btn *widget.Button) {
 	a.autoButton = btn
 }
@@ -455,6 +1041,10 @@ func (a *AppManager) SetResetButton(btn *widget.Button) {
 	a.resetButton = btn
 }
 
+func (a *AppManager) SetResetHold(h *ui.HoldToConfirm) {
+	a.resetHold = h
+}
+
 func (a *AppManager) Shutdown() {
 	if a.cmdCancel != nil {
 		a.cmdCancel()
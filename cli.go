@@ -0,0 +1,52 @@
+package main
+
+import (
+	"D2Timers/control"
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+)
+
+// runCLI implements the "d2timers cli" subcommand: a one-shot client for
+// control.Server, so D2Timers can be driven from shell scripts, OBS
+// hotkeys, or a Stream Deck plugin without the Fyne window focused.
+func runCLI(args []string) {
+	fs := flag.NewFlagSet("cli", flag.ExitOnError)
+	addr := fs.String("control-socket", control.DefaultAddr(), "address of a running D2Timers control server")
+	target := fs.String("target", "", "timer name to target (empty applies to all timers)")
+	mode := fs.String("mode", "auto", "mode for a start command: auto or manual")
+	confirm := fs.Bool("confirm", false, "required to reset every timer at once (no target)")
+	fs.Parse(args)
+
+	rest := fs.Args()
+	if len(rest) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: d2timers cli [-control-socket addr] [-target name] [-mode auto|manual] [-confirm] <start|pause|resume|reset|status>")
+		os.Exit(2)
+	}
+	cmdType := rest[0]
+
+	conn, err := net.Dial(control.Network(), *addr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "could not connect to %s: %v\n", *addr, err)
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	req := map[string]any{"type": cmdType, "target": *target, "mode": *mode, "confirm": *confirm}
+	if err := json.NewEncoder(conn).Encode(req); err != nil {
+		fmt.Fprintf(os.Stderr, "could not send command: %v\n", err)
+		os.Exit(1)
+	}
+
+	scanner := bufio.NewScanner(conn)
+	if scanner.Scan() {
+		fmt.Println(scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(os.Stderr, "could not read reply: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,188 @@
+package control
+
+import (
+	"D2Timers/timer"
+	"bufio"
+	"encoding/json"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+)
+
+// wireCommand is the JSON line a Server connection accepts, e.g.
+// {"type":"start","target":"PowerRunes","mode":"auto"} or
+// {"type":"reset","confirm":true}.
+type wireCommand struct {
+	Type    string `json:"type"`
+	Target  string `json:"target"`
+	Mode    string `json:"mode"`
+	Confirm bool   `json:"confirm"`
+}
+
+// TimerStatus is one timer's state as reported by a "status" command or
+// AppManager.Snapshot, for external HUDs and overlay tools to poll.
+type TimerStatus struct {
+	Name      string `json:"name"`
+	State     string `json:"state"`
+	Mode      string `json:"mode"`
+	Remaining int    `json:"remaining"`
+}
+
+// Dispatcher is the subset of AppManager a Server needs: enqueue a
+// command onto the existing command loop, look timers up by name, and
+// report a status snapshot.
+type Dispatcher interface {
+	EnqueueCommand(cmd Command)
+	AllTimers() []*timer.DotaTimer
+	Snapshot() []TimerStatus
+}
+
+// Server accepts newline-delimited JSON commands over a local socket and
+// forwards them into a Dispatcher's existing command loop, so external
+// tools (OBS hotkeys, a Stream Deck plugin, shell scripts) can drive
+// D2Timers without the Fyne window being focused. The "d2timers cli"
+// subcommand (see runCLI) is the matching client.
+type Server struct {
+	Addr string
+}
+
+// NewServer creates a Server listening on addr (see DefaultAddr).
+func NewServer(addr string) *Server {
+	return &Server{Addr: addr}
+}
+
+// Network is the net.Listen/net.Dial network for this platform: a Unix
+// domain socket everywhere but Windows, where the standard library has
+// no portable named-pipe support, so a localhost TCP port is used
+// instead.
+func Network() string {
+	if runtime.GOOS == "windows" {
+		return "tcp"
+	}
+	return "unix"
+}
+
+// DefaultAddr is where "d2timers cli" connects unless told otherwise.
+func DefaultAddr() string {
+	if runtime.GOOS == "windows" {
+		return "127.0.0.1:58217"
+	}
+	return filepath.Join(os.TempDir(), "d2timers.sock")
+}
+
+// Serve listens on s.Addr and blocks, handling connections until the
+// listener fails or is closed. Call it from its own goroutine.
+func (s *Server) Serve(d Dispatcher) error {
+	if Network() == "unix" {
+		os.Remove(s.Addr) // clear a stale socket file left by an unclean shutdown
+	}
+	ln, err := net.Listen(Network(), s.Addr)
+	if err != nil {
+		return err
+	}
+	defer ln.Close()
+	log.Printf("control: listening on %s", s.Addr)
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		go handleConn(d, conn)
+	}
+}
+
+func handleConn(d Dispatcher, conn net.Conn) {
+	defer conn.Close()
+
+	enc := json.NewEncoder(conn)
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		var wc wireCommand
+		if err := json.Unmarshal(scanner.Bytes(), &wc); err != nil {
+			enc.Encode(map[string]string{"error": err.Error()})
+			continue
+		}
+		handle(d, wc, enc)
+	}
+}
+
+func handle(d Dispatcher, wc wireCommand, enc *json.Encoder) {
+	if wc.Type == "status" {
+		enc.Encode(map[string]any{"status": d.Snapshot()})
+		return
+	}
+
+	cmdType, ok := commandTypeFor(wc.Type)
+	if !ok {
+		enc.Encode(map[string]string{"error": "unknown command: " + wc.Type})
+		return
+	}
+
+	// An empty target applies the command to every timer, matching the
+	// existing "Reset All" hold-to-confirm button's behavior. A reset
+	// against every timer is destructive and, unlike the UI, has no hold
+	// gesture behind it, so it additionally requires an explicit
+	// "confirm":true rather than firing on the bare command.
+	if cmdType == CmdReset && wc.Target == "" && !wc.Confirm {
+		enc.Encode(map[string]string{"error": `reset-all requires "confirm":true`})
+		return
+	}
+
+	var targets []*timer.DotaTimer
+	if wc.Target == "" {
+		targets = d.AllTimers()
+	} else {
+		for _, t := range d.AllTimers() {
+			if t.Name == wc.Target {
+				targets = append(targets, t)
+				break
+			}
+		}
+		if targets == nil {
+			enc.Encode(map[string]string{"error": "unknown target: " + wc.Target})
+			return
+		}
+	}
+
+	mode := timer.ModeAuto
+	if wc.Mode == "manual" {
+		mode = timer.ModeManual
+	}
+
+	replies := make([]chan error, 0, len(targets))
+	for _, t := range targets {
+		reply := make(chan error, 1)
+		d.EnqueueCommand(Command{Type: cmdType, Target: t, Mode: mode, Reply: reply})
+		replies = append(replies, reply)
+	}
+
+	for _, reply := range replies {
+		select {
+		case err := <-reply:
+			if err != nil {
+				enc.Encode(map[string]string{"error": err.Error()})
+				return
+			}
+		case <-time.After(time.Second):
+		}
+	}
+	enc.Encode(map[string]string{"ok": "true"})
+}
+
+func commandTypeFor(s string) (CommandType, bool) {
+	switch s {
+	case "start":
+		return CmdStart, true
+	case "pause":
+		return CmdPause, true
+	case "resume":
+		return CmdResume, true
+	case "reset":
+		return CmdReset, true
+	}
+	return 0, false
+}
@@ -0,0 +1,192 @@
+//go:build debuglink
+
+// Package debuglink implements an optional line-delimited JSON TCP server
+// used to drive D2Timers headlessly for integration testing. It forwards
+// commands into the existing control.Command queue and streams state-change
+// and alert events back to the connection, so tests can assert on the full
+// command loop + Scheduler without clicking Fyne widgets.
+//
+// The package is compiled in only when the "debuglink" build tag is set
+// (see main_debuglink.go), so release builds never expose the port.
+package debuglink
+
+import (
+	"D2Timers/control"
+	"D2Timers/events"
+	"D2Timers/timer"
+	"bufio"
+	"encoding/json"
+	"log"
+	"net"
+	"sync"
+	"time"
+)
+
+// App is the subset of AppManager the debug link needs to drive and inspect
+// the running timers. It embeds timer.App so commands that mutate a
+// DotaTimer directly (set_custom) can pass it through unchanged. Subscribe
+// gives the event stream a live feed straight off the same events.Bus the
+// journal and webhook/file sinks use, rather than polling for changes.
+type App interface {
+	timer.App
+	AllTimers() []*timer.DotaTimer
+	EnqueueCommand(cmd control.Command)
+	SetActiveProfile(name string) error
+	Subscribe() <-chan events.Event
+	Unsubscribe(ch <-chan events.Event)
+}
+
+// command is the wire format accepted from a debug-link connection.
+type command struct {
+	Cmd      string `json:"cmd"`
+	Target   string `json:"target"`
+	Mode     string `json:"mode"`
+	Duration int    `json:"duration"`
+	Profile  string `json:"profile"`
+}
+
+// event is the wire format emitted on the asynchronous event stream.
+type event struct {
+	Type     string              `json:"type"`
+	At       time.Time           `json:"at"`
+	Snapshot timer.TimerSnapshot `json:"snapshot"`
+}
+
+// Serve starts the debug-link TCP server on addr and blocks until the
+// listener fails or is closed. Call it from a goroutine.
+func Serve(a App, addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer ln.Close()
+	log.Printf("debuglink: listening on %s", addr)
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		go handleConn(a, conn)
+	}
+}
+
+func handleConn(a App, conn net.Conn) {
+	defer conn.Close()
+
+	var sendMu sync.Mutex
+	enc := json.NewEncoder(conn)
+	send := func(v any) {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		if err := enc.Encode(v); err != nil {
+			log.Printf("debuglink: write failed: %v", err)
+		}
+	}
+
+	stop := watchForEvents(a, send)
+	defer close(stop)
+
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		var c command
+		if err := json.Unmarshal(scanner.Bytes(), &c); err != nil {
+			send(map[string]string{"error": err.Error()})
+			continue
+		}
+		handleCommand(a, c, send)
+	}
+}
+
+func handleCommand(a App, c command, send func(any)) {
+	if c.Cmd == "snapshot" {
+		snaps := make([]timer.TimerSnapshot, 0, len(a.AllTimers()))
+		for _, t := range a.AllTimers() {
+			snaps = append(snaps, t.GetSnapshot())
+		}
+		send(map[string]any{"type": "snapshot", "timers": snaps})
+		return
+	}
+
+	if c.Cmd == "set_profile" {
+		if err := a.SetActiveProfile(c.Profile); err != nil {
+			send(map[string]string{"error": err.Error()})
+		}
+		return
+	}
+
+	var target *timer.DotaTimer
+	for _, t := range a.AllTimers() {
+		if t.Name == c.Target {
+			target = t
+			break
+		}
+	}
+	if target == nil {
+		send(map[string]string{"error": "unknown target: " + c.Target})
+		return
+	}
+
+	switch c.Cmd {
+	case "start":
+		mode := timer.ModeAuto
+		if c.Mode == "manual" {
+			mode = timer.ModeManual
+		}
+		a.EnqueueCommand(control.Command{Type: control.CmdStart, Target: target, Mode: mode})
+	case "pause":
+		a.EnqueueCommand(control.Command{Type: control.CmdPause, Target: target})
+	case "resume":
+		a.EnqueueCommand(control.Command{Type: control.CmdResume, Target: target})
+	case "reset":
+		a.EnqueueCommand(control.Command{Type: control.CmdReset, Target: target})
+	case "set_custom":
+		target.SetCustomDuration(a, c.Duration)
+	default:
+		send(map[string]string{"error": "unknown cmd: " + c.Cmd})
+	}
+}
+
+// watchForEvents subscribes to a's events.Bus (the same bus journal.Sink
+// and the webhook/file sinks subscribe to) and forwards each
+// EvStateChanged/EvAlertFired notification it receives, with no polling
+// interval. The attached snapshot is read when the event is forwarded,
+// not when it was published, so a transition reverted in between shows
+// the later state; events the Bus drops for a full subscriber buffer are
+// not sent. It returns a channel whose closure stops forwarding.
+func watchForEvents(a App, send func(any)) chan struct{} {
+	stop := make(chan struct{})
+	ch := a.Subscribe()
+	go func() {
+		defer a.Unsubscribe(ch)
+		for {
+			select {
+			case <-stop:
+				return
+			case ev := <-ch:
+				var typ string
+				switch ev.Kind {
+				case events.EvStateChanged:
+					typ = "state_changed"
+				case events.EvAlertFired:
+					typ = "alert"
+				default:
+					continue
+				}
+				if snap, ok := snapshotFor(a, ev.Timer); ok {
+					send(event{Type: typ, At: ev.At, Snapshot: snap})
+				}
+			}
+		}
+	}()
+	return stop
+}
+
+func snapshotFor(a App, name string) (timer.TimerSnapshot, bool) {
+	for _, t := range a.AllTimers() {
+		if t.Name == name {
+			return t.GetSnapshot(), true
+		}
+	}
+	return timer.TimerSnapshot{}, false
+}
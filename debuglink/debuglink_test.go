@@ -0,0 +1,151 @@
+//go:build debuglink
+
+package debuglink
+
+import (
+	"D2Timers/control"
+	"D2Timers/events"
+	"D2Timers/timer"
+	"bufio"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+)
+
+// fakeApp is a minimal App implementation backed by a real events.Bus, so
+// PublishEvent/Subscribe behave exactly as AppManager's do.
+type fakeApp struct {
+	timers  []*timer.DotaTimer
+	bus     *events.Bus
+	enqueue []control.Command
+}
+
+func newFakeApp(names ...string) *fakeApp {
+	a := &fakeApp{bus: events.NewBus()}
+	for _, name := range names {
+		a.timers = append(a.timers, timer.NewDotaTimer(&timer.TimerConfig{Name: name}))
+	}
+	return a
+}
+
+func (f *fakeApp) AddActiveTimer(*timer.DotaTimer)    {}
+func (f *fakeApp) RemoveActiveTimer(*timer.DotaTimer) {}
+func (f *fakeApp) PlaySound(string)                   {}
+func (f *fakeApp) Notify(*timer.DotaTimer)            {}
+func (f *fakeApp) PublishEvent(ev events.Event)       { f.bus.Publish(ev) }
+func (f *fakeApp) Subscribe() <-chan events.Event     { return f.bus.Subscribe() }
+func (f *fakeApp) Unsubscribe(ch <-chan events.Event) { f.bus.Unsubscribe(ch) }
+func (f *fakeApp) AllTimers() []*timer.DotaTimer      { return f.timers }
+func (f *fakeApp) EnqueueCommand(cmd control.Command) { f.enqueue = append(f.enqueue, cmd) }
+func (f *fakeApp) SetActiveProfile(name string) error { return nil }
+
+// readReply decodes the next JSON line written to the connection, failing
+// the test if none arrives within a short deadline.
+func readReply(t *testing.T, conn net.Conn, v any) {
+	t.Helper()
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	scanner := bufio.NewScanner(conn)
+	if !scanner.Scan() {
+		t.Fatalf("no reply received: %v", scanner.Err())
+	}
+	if err := json.Unmarshal(scanner.Bytes(), v); err != nil {
+		t.Fatalf("could not decode reply %q: %v", scanner.Bytes(), err)
+	}
+}
+
+func TestHandleConnSnapshotRoundTrip(t *testing.T) {
+	app := newFakeApp("Stack Neutrals", "Power Runes")
+	client, server := net.Pipe()
+	defer client.Close()
+	go handleConn(app, server)
+
+	if err := json.NewEncoder(client).Encode(command{Cmd: "snapshot"}); err != nil {
+		t.Fatalf("could not send snapshot command: %v", err)
+	}
+
+	var reply struct {
+		Type   string                `json:"type"`
+		Timers []timer.TimerSnapshot `json:"timers"`
+	}
+	readReply(t, client, &reply)
+
+	if reply.Type != "snapshot" {
+		t.Fatalf("type = %q, want snapshot", reply.Type)
+	}
+	if len(reply.Timers) != len(app.timers) {
+		t.Fatalf("got %d timers, want %d", len(reply.Timers), len(app.timers))
+	}
+}
+
+func TestHandleConnStartEnqueuesCommand(t *testing.T) {
+	app := newFakeApp("Stack Neutrals")
+	client, server := net.Pipe()
+	defer client.Close()
+	go handleConn(app, server)
+
+	req := command{Cmd: "start", Target: "Stack Neutrals", Mode: "manual"}
+	if err := json.NewEncoder(client).Encode(req); err != nil {
+		t.Fatalf("could not send start command: %v", err)
+	}
+
+	// handleCommand doesn't reply to a successful start, so give the
+	// server goroutine a moment to process it before asserting.
+	time.Sleep(50 * time.Millisecond)
+
+	if len(app.enqueue) != 1 {
+		t.Fatalf("EnqueueCommand called %d times, want 1", len(app.enqueue))
+	}
+	got := app.enqueue[0]
+	if got.Type != control.CmdStart || got.Target != app.timers[0] || got.Mode != timer.ModeManual {
+		t.Fatalf("unexpected enqueued command: %+v", got)
+	}
+}
+
+func TestHandleConnUnknownTarget(t *testing.T) {
+	app := newFakeApp("Stack Neutrals")
+	client, server := net.Pipe()
+	defer client.Close()
+	go handleConn(app, server)
+
+	req := command{Cmd: "pause", Target: "Nonexistent Timer"}
+	if err := json.NewEncoder(client).Encode(req); err != nil {
+		t.Fatalf("could not send pause command: %v", err)
+	}
+
+	var reply struct {
+		Error string `json:"error"`
+	}
+	readReply(t, client, &reply)
+	if reply.Error == "" {
+		t.Fatalf("expected an error reply for an unknown target, got none")
+	}
+}
+
+func TestWatchForEventsForwardsStateChanges(t *testing.T) {
+	app := newFakeApp("Stack Neutrals")
+	client, server := net.Pipe()
+	defer client.Close()
+	go handleConn(app, server)
+
+	// Give handleConn time to start its event subscriber before publishing.
+	time.Sleep(20 * time.Millisecond)
+
+	app.timers[0].Start(app, timer.ModeAuto)
+
+	var reply struct {
+		Type     string              `json:"type"`
+		Snapshot timer.TimerSnapshot `json:"snapshot"`
+	}
+	readReply(t, client, &reply)
+
+	if reply.Type != "state_changed" {
+		t.Fatalf("type = %q, want state_changed", reply.Type)
+	}
+	if reply.Snapshot.Name != "Stack Neutrals" {
+		t.Fatalf("snapshot.Name = %q, want Stack Neutrals", reply.Snapshot.Name)
+	}
+	if reply.Snapshot.State != timer.StateActiveAuto {
+		t.Fatalf("snapshot.State = %v, want StateActiveAuto", reply.Snapshot.State)
+	}
+}
@@ -0,0 +1,94 @@
+// Package events provides a lightweight typed pub/sub bus that external
+// tools (OBS overlays, Discord bots, voice coaches) can subscribe to in
+// order to react to timer activity without the app depending on any of
+// them. DotaTimer's state machine publishes through it; WebhookSink and
+// FileSink are the two built-in consumers.
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+// EventKind identifies what happened.
+type EventKind int
+
+const (
+	EvStateChanged EventKind = iota
+	EvAlertFired
+	EvProfileChanged
+	EvCustomDurationSet
+	EvPreAlert
+)
+
+// Event is one notification published on the Bus. State and Mode mirror
+// timer.TimerState/timer.TimerMode's int values rather than importing
+// those types directly, so this package has no dependency on the timer
+// package (which itself publishes through a Bus).
+type Event struct {
+	Kind      EventKind
+	Timer     string
+	At        time.Time
+	State     int
+	Mode      int
+	Remaining int
+	Profile   string
+}
+
+// subscriberBuffer is how many unconsumed events a subscriber may queue
+// before Publish starts dropping for it.
+const subscriberBuffer = 32
+
+// Bus fans a stream of Events out to any number of subscribers. Publish
+// never blocks: a subscriber that falls behind its buffer simply misses
+// events rather than stalling the publisher (DotaTimer.changeState).
+type Bus struct {
+	mu   sync.Mutex
+	subs []chan Event
+}
+
+// NewBus creates an empty Bus.
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+// Subscribe returns a channel that receives every Event published after
+// this call. The channel is never closed by the Bus; callers done
+// listening must call Unsubscribe with the same channel, or it leaks:
+// Publish keeps iterating over (and failing to deliver into) every
+// channel it was ever given.
+func (b *Bus) Subscribe() <-chan Event {
+	ch := make(chan Event, subscriberBuffer)
+	b.mu.Lock()
+	b.subs = append(b.subs, ch)
+	b.mu.Unlock()
+	return ch
+}
+
+// Unsubscribe removes ch from the Bus, so future Publish calls stop
+// iterating over it. Call it once a subscriber is done reading, e.g. when
+// Sink.Run's stop channel fires. Unsubscribing a channel not currently
+// subscribed (e.g. calling it twice) is a no-op.
+func (b *Bus) Unsubscribe(ch <-chan Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for i, sub := range b.subs {
+		if sub == ch {
+			b.subs = append(b.subs[:i], b.subs[i+1:]...)
+			return
+		}
+	}
+}
+
+// Publish fans ev out to every current subscriber, dropping it for any
+// subscriber whose buffer is full.
+func (b *Bus) Publish(ev Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, ch := range b.subs {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package events
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"sync"
+)
+
+// FileSink appends every Event it receives as one line of newline-
+// delimited JSON, for later replay or analysis.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileSink opens (creating or appending to) path for writing.
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{file: f}, nil
+}
+
+// Run subscribes to bus and writes events until stop is closed. Call it
+// from its own goroutine.
+func (s *FileSink) Run(bus *Bus, stop <-chan struct{}) {
+	ch := bus.Subscribe()
+	defer bus.Unsubscribe(ch)
+	for {
+		select {
+		case <-stop:
+			return
+		case ev := <-ch:
+			s.write(ev)
+		}
+	}
+}
+
+func (s *FileSink) write(ev Event) {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		log.Printf("events: failed to marshal event for file sink: %v", err)
+		return
+	}
+	body = append(body, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.file.Write(body); err != nil {
+		log.Printf("events: file sink write to %s failed: %v", s.file.Name(), err)
+	}
+}
+
+// Close closes the underlying file.
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
@@ -0,0 +1,52 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+)
+
+// WebhookSink POSTs every Event it receives from a Bus to URL as JSON.
+// Failures are logged and otherwise ignored: a slow or unreachable
+// webhook must never block timer state changes.
+type WebhookSink struct {
+	URL    string
+	client *http.Client
+}
+
+// NewWebhookSink creates a WebhookSink posting to url.
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{URL: url, client: &http.Client{Timeout: 5 * time.Second}}
+}
+
+// Run subscribes to bus and posts events to the webhook until stop is
+// closed. Call it from its own goroutine.
+func (s *WebhookSink) Run(bus *Bus, stop <-chan struct{}) {
+	ch := bus.Subscribe()
+	defer bus.Unsubscribe(ch)
+	for {
+		select {
+		case <-stop:
+			return
+		case ev := <-ch:
+			s.send(ev)
+		}
+	}
+}
+
+func (s *WebhookSink) send(ev Event) {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		log.Printf("events: failed to marshal event for webhook: %v", err)
+		return
+	}
+
+	resp, err := s.client.Post(s.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		log.Printf("events: webhook post to %s failed: %v", s.URL, err)
+		return
+	}
+	resp.Body.Close()
+}
@@ -46,6 +46,51 @@ var translations = map[string]map[string]string{
 		"es": "Ayuda",
 		"ru": "Помощь",
 	},
+	"Timer expired": {
+		"pt": "Temporizador expirou",
+		"es": "Temporizador expirado",
+		"ru": "Таймер истёк",
+	},
+	"Notifications": {
+		"pt": "Notificações",
+		"es": "Notificaciones",
+		"ru": "Уведомления",
+	},
+	"Keybindings": {
+		"pt": "Atalhos de teclado",
+		"es": "Atajos de teclado",
+		"ru": "Горячие клавиши",
+	},
+	"Source": {
+		"pt": "Origem",
+		"es": "Origen",
+		"ru": "Источник",
+	},
+	"Profiles": {
+		"pt": "Perfis",
+		"es": "Perfiles",
+		"ru": "Профили",
+	},
+	"Profile name": {
+		"pt": "Nome do perfil",
+		"es": "Nombre del perfil",
+		"ru": "Название профиля",
+	},
+	"Save as profile...": {
+		"pt": "Salvar como perfil...",
+		"es": "Guardar como perfil...",
+		"ru": "Сохранить как профиль...",
+	},
+	"Name": {
+		"pt": "Nome",
+		"es": "Nombre",
+		"ru": "Название",
+	},
+	"Archive completed": {
+		"pt": "Arquivar concluídos",
+		"es": "Archivar completados",
+		"ru": "Архивировать завершённые",
+	},
 }
 
 func init() {
@@ -0,0 +1,240 @@
+// Package journal records timer lifecycle events (start, alert, pause,
+// reset) to an append-only log file, in a format inspired by timer.txt:
+// one line per event with an ISO-8601 timestamp, an optional "+Tag" naming
+// the timer, and "key:value" metadata, with a leading "x " marking
+// completed or stopped sessions. A sibling done.txt holds archived
+// entries, mirroring todo.txt's todo.txt/done.txt split.
+package journal
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Entry is one recorded timer lifecycle event.
+type Entry struct {
+	Done bool
+	Tag  string
+	At   time.Time
+	Meta map[string]string
+}
+
+// Log is an in-memory, ordered view of a journal file's entries.
+type Log struct {
+	Entries []Entry
+}
+
+// Load reads every entry from path. A missing file is not an error; it
+// yields an empty Log, since the journal is created lazily on first write.
+// Lines that fail to parse (e.g. hand-edited) are skipped rather than
+// failing the whole load.
+func Load(path string) (*Log, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return &Log{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	log := &Log{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		e, err := parseEntry(line)
+		if err != nil {
+			continue
+		}
+		log.Entries = append(log.Entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return log, nil
+}
+
+func parseEntry(line string) (Entry, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return Entry{}, fmt.Errorf("journal: empty line")
+	}
+
+	var e Entry
+	if fields[0] == "x" {
+		e.Done = true
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return Entry{}, fmt.Errorf("journal: missing timestamp")
+	}
+
+	at, err := time.Parse(time.RFC3339, fields[0])
+	if err != nil {
+		return Entry{}, fmt.Errorf("journal: bad timestamp %q: %w", fields[0], err)
+	}
+	e.At = at
+
+	for _, tok := range fields[1:] {
+		switch {
+		case strings.HasPrefix(tok, "+"):
+			e.Tag = strings.ReplaceAll(strings.TrimPrefix(tok, "+"), "_", " ")
+		case strings.Contains(tok, ":"):
+			if e.Meta == nil {
+				e.Meta = make(map[string]string)
+			}
+			kv := strings.SplitN(tok, ":", 2)
+			e.Meta[kv[0]] = strings.ReplaceAll(kv[1], "_", " ")
+		}
+	}
+	return e, nil
+}
+
+// format renders e back to its on-disk line, in the same field order
+// parseEntry expects. Spaces in the tag (e.g. "Stack Neutrals") and in
+// metadata values are written as '_' so each stays a single field;
+// parseEntry turns them back into spaces.
+func (e Entry) format() string {
+	var b strings.Builder
+	if e.Done {
+		b.WriteString("x ")
+	}
+	b.WriteString(e.At.UTC().Format(time.RFC3339))
+	if e.Tag != "" {
+		b.WriteString(" +")
+		b.WriteString(strings.ReplaceAll(e.Tag, " ", "_"))
+	}
+
+	keys := make([]string, 0, len(e.Meta))
+	for k := range e.Meta {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&b, " %s:%s", k, strings.ReplaceAll(e.Meta[k], " ", "_"))
+	}
+	return b.String()
+}
+
+// WriteEvent appends a single entry to the journal file at path, creating
+// it (and its parent directory) if necessary.
+func WriteEvent(path string, e Entry) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintln(f, e.format())
+	return err
+}
+
+// WriteLog overwrites path with every entry in log, one per line. Used to
+// persist a sorted/filtered Log back to disk, or to rewrite the file with
+// entries removed (see Archive).
+func WriteLog(path string, log *Log) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	for _, e := range log.Entries {
+		if _, err := fmt.Fprintln(w, e.format()); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// SortByStart orders Entries by At, ascending or descending.
+func (l *Log) SortByStart(ascending bool) {
+	sort.Slice(l.Entries, func(i, j int) bool {
+		if ascending {
+			return l.Entries[i].At.Before(l.Entries[j].At)
+		}
+		return l.Entries[i].At.After(l.Entries[j].At)
+	})
+}
+
+// Status selects which entries Filter returns, based on their Done state.
+type Status int
+
+const (
+	StatusAll Status = iota
+	StatusActive
+	StatusDone
+)
+
+// Filter returns the entries whose tag contains substr (case-insensitive;
+// empty matches any timer) and whose Done state matches status. It backs
+// the History dialog's filter input and active/done/all toggle.
+func (l *Log) Filter(substr string, status Status) []Entry {
+	var out []Entry
+	for _, e := range l.Entries {
+		if substr != "" && !strings.Contains(strings.ToLower(e.Tag), strings.ToLower(substr)) {
+			continue
+		}
+		switch status {
+		case StatusActive:
+			if e.Done {
+				continue
+			}
+		case StatusDone:
+			if !e.Done {
+				continue
+			}
+		}
+		out = append(out, e)
+	}
+	return out
+}
+
+// Archive moves every Done entry out of the journal at path into the
+// sibling done.txt file in the same directory, so the active journal
+// stays small across long sessions.
+func Archive(path string) error {
+	log, err := Load(path)
+	if err != nil {
+		return err
+	}
+
+	var active, done []Entry
+	for _, e := range log.Entries {
+		if e.Done {
+			done = append(done, e)
+		} else {
+			active = append(active, e)
+		}
+	}
+	if len(done) == 0 {
+		return nil
+	}
+
+	donePath := filepath.Join(filepath.Dir(path), "done.txt")
+	doneLog, err := Load(donePath)
+	if err != nil {
+		return err
+	}
+	doneLog.Entries = append(doneLog.Entries, done...)
+	if err := WriteLog(donePath, doneLog); err != nil {
+		return err
+	}
+
+	return WriteLog(path, &Log{Entries: active})
+}
@@ -0,0 +1,73 @@
+package journal
+
+import (
+	"log"
+	"strconv"
+
+	"D2Timers/events"
+	"D2Timers/timer"
+)
+
+// Sink subscribes to an events.Bus and appends each event it receives to
+// the journal file at Path, in Entry's timer.txt-inspired line format.
+// It's structured like events.WebhookSink/events.FileSink so the journal
+// is just another bus subscriber rather than a special case wired
+// separately into the command loop.
+type Sink struct {
+	Path string
+}
+
+// NewSink creates a Sink appending to path.
+func NewSink(path string) *Sink {
+	return &Sink{Path: path}
+}
+
+// Run subscribes to bus and journals events until stop is closed. Call it
+// from its own goroutine.
+func (s *Sink) Run(bus *events.Bus, stop <-chan struct{}) {
+	ch := bus.Subscribe()
+	defer bus.Unsubscribe(ch)
+	for {
+		select {
+		case <-stop:
+			return
+		case ev := <-ch:
+			if err := WriteEvent(s.Path, entryFromEvent(ev)); err != nil {
+				log.Printf("journal: failed to write event: %v", err)
+			}
+		}
+	}
+}
+
+// entryFromEvent translates a bus Event into the Entry it's journaled as.
+// A session counts as Done (the "x " prefix) once its timer reaches
+// StateInactive or StateUnconfigured, mirroring a stopped/reset session.
+func entryFromEvent(ev events.Event) Entry {
+	e := Entry{Tag: ev.Timer, At: ev.At, Meta: map[string]string{}}
+
+	switch ev.Kind {
+	case events.EvStateChanged:
+		e.Meta["mode"] = modeLabel(ev.Mode)
+		e.Meta["remaining"] = strconv.Itoa(ev.Remaining)
+		e.Done = ev.State == int(timer.StateInactive) || ev.State == int(timer.StateUnconfigured)
+	case events.EvAlertFired:
+		e.Meta["event"] = "alert"
+		e.Meta["remaining"] = strconv.Itoa(ev.Remaining)
+	case events.EvProfileChanged:
+		e.Meta["event"] = "profile_changed"
+		e.Meta["profile"] = ev.Profile
+	case events.EvCustomDurationSet:
+		e.Meta["event"] = "custom_duration"
+		e.Meta["remaining"] = strconv.Itoa(ev.Remaining)
+	case events.EvPreAlert:
+		e.Meta["event"] = "pre_alert"
+	}
+	return e
+}
+
+func modeLabel(mode int) string {
+	if mode == int(timer.ModeManual) {
+		return "manual"
+	}
+	return "auto"
+}
@@ -0,0 +1,239 @@
+// Package keymap loads a user-configurable table of keyboard shortcuts
+// from assets/keybindings.yaml (overridable by a file in the user's
+// config directory) and dispatches them to named actions. It replaces
+// the hardcoded switch AppManager.HandleKeyRune used to contain.
+package keymap
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+)
+
+// AppContentReader mirrors timer.AppContentReader so this package has no
+// dependency on the timer package.
+type AppContentReader interface {
+	ReadFile(name string) ([]byte, error)
+}
+
+// App is the subset of AppManager's behavior a keybinding action can
+// invoke. AppManager lives in package main and so can't be imported
+// directly; this interface is the same narrowing other packages
+// (timer.App, ui.App, debuglink.App) already use.
+type App interface {
+	ToggleGlobalStartStop()
+	ResetAll()
+	ToggleTurbo()
+	ToggleProfiling()
+	TriggerTimer(symbol string)
+	ShowHelp()
+	ShowHistory()
+}
+
+// ActionFunc is the handler registered for a single action identifier.
+type ActionFunc func(App)
+
+var actions = map[string]ActionFunc{}
+
+// RegisterAction makes fn available as a YAML action identifier, so
+// future features can plug in new bindable actions without modifying
+// this package.
+func RegisterAction(name string, fn ActionFunc) {
+	actions[name] = fn
+}
+
+func init() {
+	RegisterAction("toggle_global", App.ToggleGlobalStartStop)
+	RegisterAction("reset_all", App.ResetAll)
+	RegisterAction("toggle_turbo", App.ToggleTurbo)
+	RegisterAction("toggle_profiling", App.ToggleProfiling)
+	RegisterAction("show_help", App.ShowHelp)
+	RegisterAction("show_history", App.ShowHistory)
+}
+
+// Binding is one entry of the active keymap, exposed for the
+// Keybindings dialog.
+type Binding struct {
+	Token  string
+	Action string
+}
+
+// Keymap is a loaded, ready-to-dispatch keybinding table.
+type Keymap struct {
+	bindings map[string]string // key token -> action identifier
+	Source   string            // human-readable origin, shown in the Keybindings dialog
+}
+
+// Default returns the built-in keybinding table: the keys the old
+// HandleKeyRune switch handled, minus its reset key (see defaultBindings),
+// plus '~' for the profiling overlay.
+func Default() *Keymap {
+	return &Keymap{
+		bindings: defaultBindings(),
+		Source:   "built-in defaults",
+	}
+}
+
+// defaultBindings intentionally has no binding for reset_all: ResetAll
+// fires the footer Reset button's OnConfirm immediately (see
+// AppManager.ResetAll), bypassing the 800ms hold gesture HoldToConfirm
+// exists to enforce. A single keypress resetting every timer would be
+// more dangerous than the pre-hold-gesture behavior, not safer, so the
+// action is only reachable through the mouse hold gesture (or Shift+click)
+// on the Reset button unless a user explicitly rebinds it in their own
+// keybindings.yaml override. The Reset button's own OnTapped is nil, so
+// activating it from the keyboard does nothing.
+func defaultBindings() map[string]string {
+	return map[string]string{
+		"space": "toggle_global",
+		"t":     "toggle_turbo",
+		"~":     "toggle_profiling",
+		"z":     "timer:StackNeutrals",
+		"x":     "timer:PowerRunes",
+		"c":     "timer:ShrinesOfWisdom",
+		"v":     "timer:CustomTimer",
+	}
+}
+
+// Load reads assets/keybindings.yaml via reader, falling back to the
+// built-in defaults if it is missing, then layers an optional override
+// file from overridePath on top, if present.
+func Load(reader AppContentReader, overridePath string) (*Keymap, error) {
+	km := Default()
+
+	if data, err := reader.ReadFile("assets/keybindings.yaml"); err != nil {
+		log.Printf("keymap: assets/keybindings.yaml not found, using built-in defaults: %v", err)
+	} else {
+		var bindings map[string]string
+		if err := yaml.Unmarshal(data, &bindings); err != nil {
+			return nil, fmt.Errorf("keymap: parsing assets/keybindings.yaml: %w", err)
+		}
+		km.bindings = bindings
+		km.Source = "assets/keybindings.yaml"
+	}
+
+	if overridePath == "" {
+		return km, nil
+	}
+	data, err := os.ReadFile(overridePath)
+	if err != nil {
+		return km, nil
+	}
+	var overrides map[string]string
+	if err := yaml.Unmarshal(data, &overrides); err != nil {
+		return nil, fmt.Errorf("keymap: parsing %s: %w", overridePath, err)
+	}
+	for token, action := range overrides {
+		km.bindings[token] = action
+	}
+	km.Source = overridePath
+	return km, nil
+}
+
+// DefaultOverridePath returns where a user's personal keybindings.yaml
+// override is looked for.
+func DefaultOverridePath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = "."
+	}
+	return filepath.Join(dir, "D2Timers", "keybindings.yaml")
+}
+
+// Bindings returns the active mapping sorted by token, for display in
+// the Keybindings dialog.
+func (k *Keymap) Bindings() []Binding {
+	out := make([]Binding, 0, len(k.bindings))
+	for token, action := range k.bindings {
+		out = append(out, Binding{Token: token, Action: action})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Token < out[j].Token })
+	return out
+}
+
+// Dispatch runs whichever action is bound to a named key event (space,
+// f5, escape, ...). Plain printable keys are dispatched separately; see
+// BindTo.
+func (k *Keymap) Dispatch(a App, event *fyne.KeyEvent) {
+	token := tokenForKey(event.Name)
+	if len(token) <= 1 || token == "space" {
+		// Single characters and space also arrive as a typed rune;
+		// dispatching them here too would run the action twice.
+		return
+	}
+	k.runAction(a, k.bindings[token])
+}
+
+// BindTo wires k onto w: printable characters (letters, digits, '~',
+// space) dispatch through Canvas.SetOnTypedRune, named keys (f5,
+// escape, ...) through Dispatch via Canvas.SetOnTypedKey, and
+// "ctrl+"-prefixed bindings register a desktop.CustomShortcut so the
+// modifier reaches us.
+func (k *Keymap) BindTo(a App, w fyne.Window) {
+	w.Canvas().SetOnTypedRune(func(r rune) {
+		k.runAction(a, k.bindings[tokenForRune(r)])
+	})
+	w.Canvas().SetOnTypedKey(func(event *fyne.KeyEvent) {
+		k.Dispatch(a, event)
+	})
+
+	for token, action := range k.bindings {
+		if !strings.HasPrefix(token, "ctrl+") {
+			continue
+		}
+		action := action
+		shortcut := &desktop.CustomShortcut{
+			KeyName:  keyNameFor(strings.TrimPrefix(token, "ctrl+")),
+			Modifier: fyne.KeyModifierControl,
+		}
+		w.Canvas().AddShortcut(shortcut, func(fyne.Shortcut) {
+			k.runAction(a, action)
+		})
+	}
+}
+
+func (k *Keymap) runAction(a App, action string) {
+	if action == "" {
+		return
+	}
+	if rest, ok := timerAction(action); ok {
+		a.TriggerTimer(rest)
+		return
+	}
+	fn, ok := actions[action]
+	if !ok {
+		log.Printf("keymap: unknown action %q", action)
+		return
+	}
+	fn(a)
+}
+
+func timerAction(action string) (string, bool) {
+	if !strings.HasPrefix(action, "timer:") {
+		return "", false
+	}
+	return strings.TrimPrefix(action, "timer:"), true
+}
+
+func tokenForRune(r rune) string {
+	if r == ' ' {
+		return "space"
+	}
+	return strings.ToLower(string(r))
+}
+
+func tokenForKey(name fyne.KeyName) string {
+	return strings.ToLower(string(name))
+}
+
+func keyNameFor(token string) fyne.KeyName {
+	return fyne.KeyName(strings.ToUpper(token))
+}
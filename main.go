@@ -1,10 +1,15 @@
 package main
 
 import (
+	"D2Timers/control"
 	"D2Timers/ui"
 	"context"
 	"embed"
+	"flag"
 	"log"
+	"os"
+	"runtime"
+	"runtime/pprof"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/app"
@@ -14,6 +19,31 @@ import (
 var content embed.FS
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "cli" {
+		runCLI(os.Args[2:])
+		return
+	}
+
+	debugLinkAddr := flag.String("debug-link", os.Getenv("D2TIMERS_DEBUG_LINK"), "address for the debuglink automation socket (debuglink builds only)")
+	// Off by default: the control socket is an unauthenticated local
+	// endpoint (a world-discoverable path on a shared machine), so it
+	// must be explicitly opted into rather than listening on every run.
+	// Pass control.DefaultAddr() (or any address) to enable it.
+	controlAddr := flag.String("control-socket", os.Getenv("D2TIMERS_CONTROL_SOCKET"), "address for the scriptable control socket used by 'd2timers cli' (empty, the default, disables it)")
+	cpuProfilePath := flag.String("cpuprofile", "", "write a CPU profile to this path")
+	memProfilePath := flag.String("memprofile", "", "write a heap profile to this path on exit")
+	flag.Parse()
+
+	if *cpuProfilePath != "" {
+		f, err := os.Create(*cpuProfilePath)
+		if err != nil {
+			log.Fatalf("could not create CPU profile: %v", err)
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			log.Fatalf("could not start CPU profile: %v", err)
+		}
+	}
+
 	fyneApp := app.New()
 
 	if iconBytes, err := content.ReadFile("assets/icon.png"); err == nil {
@@ -37,9 +67,36 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	w.SetOnClosed(func() {
 		cancel()
+
+		if *cpuProfilePath != "" {
+			pprof.StopCPUProfile()
+		}
+		if *memProfilePath != "" {
+			f, err := os.Create(*memProfilePath)
+			if err != nil {
+				log.Printf("could not create memory profile: %v", err)
+				return
+			}
+			defer f.Close()
+			runtime.GC()
+			if err := pprof.WriteHeapProfile(f); err != nil {
+				log.Printf("could not write memory profile: %v", err)
+			}
+		}
 	})
 
-	go a.tick(ctx)
+	go a.RunScheduler(ctx)
+	go a.redraw(ctx)
+	go a.RunJournal(ctx)
+	startDebugLink(a, *debugLinkAddr)
+
+	if *controlAddr != "" {
+		go func() {
+			if err := control.NewServer(*controlAddr).Serve(a); err != nil {
+				log.Printf("control: server stopped: %v", err)
+			}
+		}()
+	}
 
 	w.ShowAndRun()
 }
@@ -0,0 +1,22 @@
+//go:build debuglink
+
+package main
+
+import (
+	"D2Timers/debuglink"
+	"log"
+)
+
+// startDebugLink spins up the debuglink TCP server in the background when
+// addr is non-empty. It is a no-op build (see main_debuglink_stub.go) unless
+// the binary was compiled with `-tags debuglink`.
+func startDebugLink(a *AppManager, addr string) {
+	if addr == "" {
+		return
+	}
+	go func() {
+		if err := debuglink.Serve(a, addr); err != nil {
+			log.Printf("debuglink: server stopped: %v", err)
+		}
+	}()
+}
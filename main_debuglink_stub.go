@@ -0,0 +1,7 @@
+//go:build !debuglink
+
+package main
+
+// startDebugLink is a no-op in release builds; the debuglink package is
+// only compiled in with `-tags debuglink`.
+func startDebugLink(a *AppManager, addr string) {}
@@ -0,0 +1,79 @@
+// Package notify sends OS-level desktop notifications, trying a
+// platform-specific backend (osascript on macOS, notify-send on Linux, a
+// PowerShell ToastNotification on Windows) and falling back to a log line
+// when none is available.
+package notify
+
+import (
+	"fmt"
+	"log"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Send shows a desktop notification with title and body. icon, if
+// non-empty, is a path to an image file the backend may use; backends
+// that don't support icons ignore it. Failures are logged, never
+// returned: a missing notification backend must not interrupt the timer
+// it's reporting on.
+func Send(title, body, icon string) {
+	var err error
+	switch runtime.GOOS {
+	case "darwin":
+		err = sendDarwin(title, body)
+	case "linux":
+		err = sendLinux(title, body, icon)
+	case "windows":
+		err = sendWindows(title, body)
+	default:
+		err = fmt.Errorf("unsupported platform %q", runtime.GOOS)
+	}
+	if err != nil {
+		log.Printf("notify: desktop notification failed, falling back to log only: %v", err)
+		log.Printf("notify: %s: %s", title, body)
+	}
+}
+
+func sendDarwin(title, body string) error {
+	script := fmt.Sprintf("display notification %s with title %s", appleScriptQuote(body), appleScriptQuote(title))
+	return exec.Command("osascript", "-e", script).Run()
+}
+
+func sendLinux(title, body, icon string) error {
+	args := []string{}
+	if icon != "" {
+		args = append(args, "-i", icon)
+	}
+	args = append(args, title, body)
+	return exec.Command("notify-send", args...).Run()
+}
+
+func sendWindows(title, body string) error {
+	script := fmt.Sprintf(
+		`[Windows.UI.Notifications.ToastNotificationManager, Windows.UI.Notifications, ContentType = WindowsRuntime] | Out-Null; `+
+			`$template = [Windows.UI.Notifications.ToastNotificationManager]::GetTemplateContent([Windows.UI.Notifications.ToastTemplateType]::ToastText02); `+
+			`$texts = $template.GetElementsByTagName("text"); `+
+			`$texts.Item(0).AppendChild($template.CreateTextNode(%s)) | Out-Null; `+
+			`$texts.Item(1).AppendChild($template.CreateTextNode(%s)) | Out-Null; `+
+			`$toast = [Windows.UI.Notifications.ToastNotification]::new($template); `+
+			`[Windows.UI.Notifications.ToastNotificationManager]::CreateToastNotifier("D2Timers").Show($toast)`,
+		psQuote(title), psQuote(body),
+	)
+	return exec.Command("powershell", "-NoProfile", "-Command", script).Run()
+}
+
+// appleScriptQuote wraps s as an AppleScript double-quoted string literal,
+// escaping the characters AppleScript treats specially inside one.
+func appleScriptQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
+
+// psQuote wraps s in single quotes for embedding in a PowerShell
+// one-liner, doubling any embedded single quote the way PowerShell
+// expects.
+func psQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -78,6 +78,23 @@ type TimerConfig struct {
 	Turbo_Manual_Initial  int `yaml:"Turbo_Manual_Initial"`
 	Turbo_Manual_Repeat   int `yaml:"Turbo_Manual_Repeat"`
 	BackgroundImageName string `yaml:"BackgroundImageName"`
+	// Notify controls whether this timer's alerts fire a desktop
+	// notification alongside the sound. Defaults to true (see
+	// UnmarshalYAML) so existing config files don't need updating.
+	Notify bool `yaml:"Notify"`
+}
+
+// UnmarshalYAML implements the yaml.v3 obsolete-style Unmarshaler so that
+// Notify defaults to true when the key is absent from the config file,
+// instead of yaml's usual zero-value bool default.
+func (c *TimerConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type rawConfig TimerConfig
+	raw := rawConfig{Notify: true}
+	if err := unmarshal(&raw); err != nil {
+		return err
+	}
+	*c = TimerConfig(raw)
+	return nil
 }
 
 // TimerConfigs holds the configuration for all default timers.
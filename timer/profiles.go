@@ -0,0 +1,199 @@
+package timer
+
+import (
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TimerProfileValues holds one profile's Auto/Manual duration overrides
+// for a single timer, keyed by the timer's Name in Profile.Timers.
+type TimerProfileValues struct {
+	Auto_Initial   int `yaml:"Auto_Initial"`
+	Auto_Repeat    int `yaml:"Auto_Repeat"`
+	Manual_Initial int `yaml:"Manual_Initial"`
+	Manual_Repeat  int `yaml:"Manual_Repeat"`
+	// CustomDuration, if non-zero, seeds a Custom Timer-style entry's
+	// user-chosen duration (see DotaTimer.SetCustomDuration).
+	CustomDuration int `yaml:"CustomDuration,omitempty"`
+}
+
+// Profile is a named, switchable set of per-timer duration overrides,
+// e.g. Normal, Turbo, Ability Draft, Custom-Slow. A timer whose Name
+// doesn't appear in Timers keeps whatever values the previously active
+// profile left it with.
+type Profile struct {
+	Name   string                        `yaml:"Name"`
+	Timers map[string]TimerProfileValues `yaml:"Timers"`
+
+	// Source records where the profile was loaded from (a file path, or
+	// "built-in defaults"). No UI currently displays it.
+	Source string `yaml:"-"`
+}
+
+// Apply overwrites every timer in timers whose Name appears in p.Timers
+// with p's Auto/Manual values, and, if CustomDuration is set, with its
+// custom duration too.
+func (p *Profile) Apply(a App, timers []*DotaTimer) {
+	for _, t := range timers {
+		v, ok := p.Timers[t.Name]
+		if !ok {
+			continue
+		}
+		t.SetNormal_Auto_InitialRepeat(v.Auto_Initial, v.Auto_Repeat)
+		t.SetNormal_Manual_InitialRepeat(v.Manual_Initial, v.Manual_Repeat)
+		if v.CustomDuration != 0 {
+			t.SetCustomDuration(a, v.CustomDuration)
+		}
+	}
+}
+
+// Profiles holds every profile discovered by LoadProfiles, in load
+// order. "Normal" (synthesized from each TimerConfig's own Normal_*
+// values) is always first, followed by "Turbo" if any timer defines
+// turbo values, so switching away from a custom profile always has
+// somewhere built-in to fall back to.
+var Profiles []*Profile
+
+// LoadProfiles populates Profiles from assets/profiles/*.yaml (via
+// reader, if it supports directory listing) and then userDir, in that
+// order; a file whose Name matches an already-loaded profile replaces
+// it. Missing directories are not an error: players who never touch
+// profiles still get Normal (and Turbo, if configured).
+func LoadProfiles(reader AppContentReader, userDir string) {
+	Profiles = []*Profile{normalProfile()}
+	if turbo := turboProfile(); turbo != nil {
+		Profiles = append(Profiles, turbo)
+	}
+
+	if dr, ok := reader.(dirReader); ok {
+		loadProfileDir(dr.ReadDir, reader.ReadFile, "assets/profiles")
+	}
+
+	if userDir == "" {
+		return
+	}
+	loadProfileDir(func(name string) ([]fs.DirEntry, error) { return os.ReadDir(name) },
+		os.ReadFile, userDir)
+}
+
+// dirReader is implemented by embed.FS in addition to AppContentReader;
+// LoadProfiles type-asserts for it rather than widening
+// AppContentReader, since every other package consuming it only ever
+// reads a single named file.
+type dirReader interface {
+	ReadDir(name string) ([]fs.DirEntry, error)
+}
+
+func loadProfileDir(readDir func(string) ([]fs.DirEntry, error), readFile func(string) ([]byte, error), dir string) {
+	entries, err := readDir(dir)
+	if err != nil {
+		log.Printf("profiles: %s not found, skipping: %v", dir, err)
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".yaml") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		data, err := readFile(path)
+		if err != nil {
+			log.Printf("profiles: reading %s: %v", path, err)
+			continue
+		}
+		addProfile(data, path)
+	}
+}
+
+// addProfile parses data as a Profile and inserts it into Profiles,
+// replacing any existing profile with the same Name.
+func addProfile(data []byte, source string) {
+	var p Profile
+	if err := yaml.Unmarshal(data, &p); err != nil {
+		log.Printf("profiles: parsing %s: %v", source, err)
+		return
+	}
+	if p.Name == "" {
+		log.Printf("profiles: %s has no Name, skipping", source)
+		return
+	}
+	p.Source = source
+
+	for i, existing := range Profiles {
+		if existing.Name == p.Name {
+			Profiles[i] = &p
+			return
+		}
+	}
+	Profiles = append(Profiles, &p)
+}
+
+// normalProfile synthesizes the always-present "Normal" profile from
+// each loaded TimerConfig's own Normal_* values.
+func normalProfile() *Profile {
+	p := &Profile{Name: "Normal", Timers: map[string]TimerProfileValues{}, Source: "built-in defaults"}
+	for _, cfg := range TimerConfigs {
+		p.Timers[cfg.Name] = TimerProfileValues{
+			Auto_Initial:   cfg.Normal_Auto_Initial,
+			Auto_Repeat:    cfg.Normal_Auto_Repeat,
+			Manual_Initial: cfg.Normal_Manual_Initial,
+			Manual_Repeat:  cfg.Normal_Manual_Repeat,
+		}
+	}
+	return p
+}
+
+// turboProfile synthesizes a "Turbo" profile from each TimerConfig's
+// Turbo_* values, for timers that define any (Turbo_Auto_Initial != 0),
+// falling back to that timer's Normal_* values otherwise. Returns nil if
+// no timer defines turbo values at all.
+func turboProfile() *Profile {
+	p := &Profile{Name: "Turbo", Timers: map[string]TimerProfileValues{}, Source: "built-in defaults"}
+	any := false
+	for _, cfg := range TimerConfigs {
+		if cfg.Turbo_Auto_Initial != 0 {
+			any = true
+			p.Timers[cfg.Name] = TimerProfileValues{
+				Auto_Initial:   cfg.Turbo_Auto_Initial,
+				Auto_Repeat:    cfg.Turbo_Auto_Repeat,
+				Manual_Initial: cfg.Turbo_Manual_Initial,
+				Manual_Repeat:  cfg.Turbo_Manual_Repeat,
+			}
+		} else {
+			p.Timers[cfg.Name] = TimerProfileValues{
+				Auto_Initial:   cfg.Normal_Auto_Initial,
+				Auto_Repeat:    cfg.Normal_Auto_Repeat,
+				Manual_Initial: cfg.Normal_Manual_Initial,
+				Manual_Repeat:  cfg.Normal_Manual_Repeat,
+			}
+		}
+	}
+	if !any {
+		return nil
+	}
+	return p
+}
+
+// ProfileNames returns every loaded profile's Name, in load order, for
+// populating the profile combobox.
+func ProfileNames() []string {
+	names := make([]string, len(Profiles))
+	for i, p := range Profiles {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// FindProfile returns the loaded profile named name, if any.
+func FindProfile(name string) (*Profile, bool) {
+	for _, p := range Profiles {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return nil, false
+}
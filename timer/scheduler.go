@@ -0,0 +1,179 @@
+package timer
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+// TickKind identifies why a Scheduler entry was registered. A single timer
+// may eventually hold more than one live entry at a time, e.g. a
+// TickPreAlert warning a few seconds ahead of its TickExpire.
+type TickKind int
+
+const (
+	TickExpire TickKind = iota
+	TickPreAlert
+)
+
+// schedulerEntry is one heap element: fire callback once deadline elapses.
+type schedulerEntry struct {
+	deadline time.Time
+	timer    *DotaTimer
+	kind     TickKind
+	callback func()
+	index    int
+}
+
+// entryHeap is a container/heap min-heap ordered by deadline.
+type entryHeap []*schedulerEntry
+
+func (h entryHeap) Len() int           { return len(h) }
+func (h entryHeap) Less(i, j int) bool { return h[i].deadline.Before(h[j].deadline) }
+func (h entryHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index, h[j].index = i, j
+}
+
+func (h *entryHeap) Push(x any) {
+	e := x.(*schedulerEntry)
+	e.index = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *entryHeap) Pop() any {
+	old := *h
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	e.index = -1
+	*h = old[:n-1]
+	return e
+}
+
+// Scheduler replaces a fixed-rate 1Hz tick goroutine with a min-heap of
+// (deadline, *DotaTimer, callback) entries driven by a single time.Timer set
+// to the nearest deadline. Deadlines are wall-clock times computed once at
+// Start/Resume, so cadence doesn't drift under load and idle periods (no
+// active timers) cost nothing.
+type Scheduler struct {
+	mu      sync.Mutex
+	entries entryHeap
+	byTimer map[*DotaTimer][]*schedulerEntry
+	wake    chan struct{}
+}
+
+// NewScheduler creates an empty Scheduler. Call Run (typically in its own
+// goroutine) to start firing entries.
+func NewScheduler() *Scheduler {
+	return &Scheduler{byTimer: make(map[*DotaTimer][]*schedulerEntry)}
+}
+
+// ScheduleAt registers callback to fire once when elapses. kind tags the
+// entry so a timer can hold several concurrent entries (see TickKind) and
+// have them cancelled together via Cancel.
+func (s *Scheduler) ScheduleAt(t *DotaTimer, when time.Time, kind TickKind, callback func()) {
+	s.mu.Lock()
+	e := &schedulerEntry{deadline: when, timer: t, kind: kind, callback: callback}
+	heap.Push(&s.entries, e)
+	s.byTimer[t] = append(s.byTimer[t], e)
+	s.mu.Unlock()
+	s.rearm()
+}
+
+// Cancel drops every pending entry registered for t, regardless of kind.
+func (s *Scheduler) Cancel(t *DotaTimer) {
+	s.mu.Lock()
+	for _, e := range s.byTimer[t] {
+		if e.index >= 0 {
+			heap.Remove(&s.entries, e.index)
+		}
+	}
+	delete(s.byTimer, t)
+	s.mu.Unlock()
+	s.rearm()
+}
+
+// rearm wakes the Run loop so it recomputes its wait against the current
+// nearest deadline, used whenever ScheduleAt/Cancel changes that deadline.
+func (s *Scheduler) rearm() {
+	s.mu.Lock()
+	ch := s.wake
+	s.mu.Unlock()
+	if ch == nil {
+		return
+	}
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+// Run blocks, firing due entries as their deadlines elapse, until ctx is
+// done. It's meant to be started once in its own goroutine, analogous to
+// the old AppManager.tick(ctx).
+func (s *Scheduler) Run(ctx context.Context) {
+	s.mu.Lock()
+	s.wake = make(chan struct{}, 1)
+	s.mu.Unlock()
+
+	for {
+		s.mu.Lock()
+		var wait time.Duration
+		if len(s.entries) == 0 {
+			wait = time.Hour // idle; rearm() interrupts this the moment something is scheduled
+		} else {
+			wait = time.Until(s.entries[0].deadline)
+			if wait < 0 {
+				wait = 0
+			}
+		}
+		wakeCh := s.wake
+		s.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			s.fireDue()
+		case <-wakeCh:
+			timer.Stop()
+		}
+	}
+}
+
+// fireDue pops every entry whose deadline has elapsed and invokes its
+// callback outside the lock, so callbacks are free to call back into the
+// Scheduler (e.g. to reschedule a repeating timer).
+func (s *Scheduler) fireDue() {
+	now := time.Now()
+	var due []*schedulerEntry
+
+	s.mu.Lock()
+	for len(s.entries) > 0 && !s.entries[0].deadline.After(now) {
+		e := heap.Pop(&s.entries).(*schedulerEntry)
+		s.removeFromByTimerLocked(e)
+		due = append(due, e)
+	}
+	s.mu.Unlock()
+
+	for _, e := range due {
+		e.callback()
+	}
+}
+
+func (s *Scheduler) removeFromByTimerLocked(e *schedulerEntry) {
+	entries := s.byTimer[e.timer]
+	for i, other := range entries {
+		if other == e {
+			s.byTimer[e.timer] = append(entries[:i], entries[i+1:]...)
+			break
+		}
+	}
+	if len(s.byTimer[e.timer]) == 0 {
+		delete(s.byTimer, e.timer)
+	}
+}
@@ -10,10 +10,19 @@
 //   - The DotaTimer exposes minimal methods for state transitions (Start,
 //     Pause, Resume, Reset, Tick). Prefer calling these through the centralized
 //     application command loop to keep behavior deterministic.
+//   - State transitions are computed by step(Event) Transition, a pure
+//     function of the timer's current state, and applied by changeState,
+//     which mutates State/mode for every transition. This keeps the legal
+//     (state, event) matrix in one place and makes it unit-testable without
+//     Fyne. SetCustomDuration is the one other writer of State: it sets
+//     StateInactive directly, with no Transition and no
+//     AddActiveTimer/RemoveActiveTimer call.
 package timer
 
 import (
+	"D2Timers/events"
 	"sync"
+	"time"
 
 	"fyne.io/fyne/v2"
 )
@@ -23,6 +32,8 @@ type App interface {
 	AddActiveTimer(*DotaTimer)
 	RemoveActiveTimer(*DotaTimer)
 	PlaySound(string)
+	Notify(*DotaTimer)
+	PublishEvent(events.Event)
 }
 
 // TimerUI is the minimal interface the timer logic expects from the UI side.
@@ -31,6 +42,59 @@ type TimerUI interface {
 	UpdateDisplay()
 }
 
+// eventKind identifies which external stimulus an Event carries.
+type eventKind int
+
+const (
+	evStart eventKind = iota
+	evPause
+	evResume
+	evReset
+	evTick
+)
+
+// Event is the external stimulus fed into DotaTimer.step to compute the
+// Transition that applies. Construct one with EvStart/EvPause/EvResume/
+// EvReset/EvTick rather than the zero value.
+type Event struct {
+	kind eventKind
+	mode TimerMode
+}
+
+// EvStart requests the timer begin counting down in the given mode.
+func EvStart(mode TimerMode) Event { return Event{kind: evStart, mode: mode} }
+
+// EvPause requests an active timer stop counting down.
+func EvPause() Event { return Event{kind: evPause} }
+
+// EvResume requests a paused timer continue counting down.
+func EvResume() Event { return Event{kind: evResume} }
+
+// EvReset requests the timer return to its initial state.
+func EvReset() Event { return Event{kind: evReset} }
+
+// EvTick represents the Scheduler reaching the timer's deadline (see Expire).
+func EvTick() Event { return Event{kind: evTick} }
+
+// Transition is the effect that step decides an Event should have on a
+// DotaTimer. changeState is the only place that interprets a Transition.
+type Transition int
+
+const (
+	// TransNone means the event has no effect in the timer's current
+	// state: either it's a legitimate no-op (a tick that hasn't reached
+	// zero yet) or an illegal transition (e.g. Resume while not paused),
+	// which callers count via illegalTransitions.
+	TransNone Transition = iota
+	TransToActiveAuto
+	TransToActiveManual
+	TransToPaused
+	TransToInactive
+	TransToUnconfigured
+	TransAlertAndRepeat
+	TransAlertAndStop
+)
+
 // DotaTimer represents a single timer's state and logic.
 type DotaTimer struct {
 	*TimerConfig
@@ -43,15 +107,18 @@ type DotaTimer struct {
 	cycleDuration     int
 	CustomDurationSec int
 
-	// original config values, stored for turbo mode toggling
-	originalNormal_Auto_Initial   int
-	originalNormal_Auto_Repeat    int
-	originalTurbo_Auto_Initial      int
-	originalTurbo_Auto_Repeat       int
-	originalNormal_Manual_Initial     int
-	originalNormal_Manual_Repeat      int
-	originalTurbo_Manual_Initial  int
-	originalTurbo_Manual_Repeat   int
+	// deadline is the wall-clock instant an active timer will expire at,
+	// computed once on Start/Resume rather than decremented every second;
+	// GetRemaining derives the countdown from it, so cadence never drifts.
+	// pausedRemaining freezes the countdown across a pause so Resume can
+	// rebuild deadline from where it left off.
+	deadline        time.Time
+	pausedRemaining time.Duration
+
+	// illegalTransitions counts events that step() rejected in the
+	// timer's current state (e.g. Resume while not paused). Surfaced via
+	// GetSnapshot for diagnostics.
+	illegalTransitions int
 
 	// UI components are stored here but managed by the UI package.
 	UI TimerUI // Holds the associated UI component, managed by the ui package
@@ -67,17 +134,9 @@ func (t *DotaTimer) GetMode() TimerMode {
 // NewDotaTimer creates a new timer based on a config.
 func NewDotaTimer(c *TimerConfig) *DotaTimer {
 	t := &DotaTimer{
-		TimerConfig:                 c,
-		State:                       StateInactive,
-		mode:                        ModeAuto,
-		originalNormal_Auto_Initial:   c.Normal_Auto_Initial,
-		originalNormal_Auto_Repeat:    c.Normal_Auto_Repeat,
-		originalTurbo_Auto_Initial:      c.Turbo_Auto_Initial,
-		originalTurbo_Auto_Repeat:       c.Turbo_Auto_Repeat,
-		originalNormal_Manual_Initial:     c.Normal_Manual_Initial,
-		originalNormal_Manual_Repeat:      c.Normal_Manual_Repeat,
-		originalTurbo_Manual_Initial:  c.Turbo_Manual_Initial,
-		originalTurbo_Manual_Repeat:   c.Turbo_Manual_Repeat,
+		TimerConfig: c,
+		State:       StateInactive,
+		mode:        ModeAuto,
 	}
 	if c.Name == "Custom Timer" {
 		t.State = StateUnconfigured
@@ -85,14 +144,91 @@ func NewDotaTimer(c *TimerConfig) *DotaTimer {
 	return t
 }
 
-func (t *DotaTimer) changeState(a App, newState TimerState, newMode TimerMode) {
+// step computes the Transition that ev produces given the timer's current
+// state, without mutating anything. Callers must hold t.mu. TransNone means
+// either a legitimate no-op (a tick that hasn't reached zero) or an illegal
+// transition; see each case for which.
+func (t *DotaTimer) step(ev Event) Transition {
+	switch ev.kind {
+	case evStart:
+		if t.Name == "Custom Timer" && t.CustomDurationSec == 0 {
+			return TransNone // illegal: custom timer has no duration set yet
+		}
+		if ev.mode == ModeManual {
+			return TransToActiveManual
+		}
+		return TransToActiveAuto
+	case evPause:
+		if t.State == StateActiveAuto || t.State == StateActiveManual {
+			return TransToPaused
+		}
+		return TransNone // illegal: nothing to pause
+	case evResume:
+		if t.State != StatePaused {
+			return TransNone // illegal: nothing to resume
+		}
+		if t.mode == ModeManual {
+			return TransToActiveManual
+		}
+		return TransToActiveAuto
+	case evReset:
+		if t.Name == "Custom Timer" {
+			return TransToUnconfigured
+		}
+		return TransToInactive
+	case evTick:
+		if t.remainingLocked() > 0 {
+			return TransNone // legitimate no-op: scheduler fired early/twice
+		}
+		if t.Name == "Custom Timer" && t.State == StateActiveManual {
+			return TransAlertAndStop
+		}
+		return TransAlertAndRepeat
+	default:
+		return TransNone
+	}
+}
+
+// changeState applies every Transition to State/mode. It interprets trans
+// and fires the App-level side effects (AddActiveTimer/RemoveActiveTimer)
+// that follow from the resulting state. SetCustomDuration also writes
+// State (to StateInactive) without going through here.
+func (t *DotaTimer) changeState(a App, trans Transition) {
+	var newState TimerState
+	var newMode TimerMode
+
+	switch trans {
+	case TransToActiveAuto:
+		newState, newMode = StateActiveAuto, ModeAuto
+	case TransToActiveManual:
+		newState, newMode = StateActiveManual, ModeManual
+	case TransToPaused:
+		t.mu.RLock()
+		newMode = t.mode
+		t.mu.RUnlock()
+		newState = StatePaused
+	case TransToInactive:
+		newState, newMode = StateInactive, ModeAuto
+	case TransToUnconfigured:
+		newState, newMode = StateUnconfigured, ModeAuto
+	default:
+		return
+	}
+
 	t.mu.Lock()
 	t.State = newState
-	if newMode != 0 {
-		t.mode = newMode
-	}
+	t.mode = newMode
 	t.mu.Unlock()
 
+	a.PublishEvent(events.Event{
+		Kind:      events.EvStateChanged,
+		Timer:     t.Name,
+		At:        time.Now(),
+		State:     int(newState),
+		Mode:      int(newMode),
+		Remaining: t.GetRemaining(),
+	})
+
 	switch newState {
 	case StateActiveAuto, StateActiveManual:
 		a.AddActiveTimer(t)
@@ -104,115 +240,163 @@ func (t *DotaTimer) changeState(a App, newState TimerState, newMode TimerMode) {
 // Start begins the timer's countdown.
 func (t *DotaTimer) Start(a App, mode TimerMode) {
 	t.mu.Lock()
-	if t.Name == "Custom Timer" && t.CustomDurationSec == 0 {
+	trans := t.step(EvStart(mode))
+	if trans == TransNone {
+		t.illegalTransitions++
 		t.mu.Unlock()
 		return
 	}
 
-	var newState TimerState
-	switch mode {
-	case ModeAuto:
-		newState = StateActiveAuto
-	case ModeManual:
-		newState = StateActiveManual
-	default:
-		newState = StateActiveManual
-	}
-
 	if t.State != StatePaused {
 		isAuto := mode == ModeAuto
+		var initial, repeat int
 		if t.Name == "Custom Timer" {
-			t.Remaining = t.CustomDurationSec
-			t.cycleDuration = t.CustomDurationSec
+			initial, repeat = t.CustomDurationSec, t.CustomDurationSec
 		} else if isAuto {
-			t.Remaining = t.Normal_Auto_Initial
-			t.cycleDuration = t.Normal_Auto_Repeat
+			initial, repeat = t.Normal_Auto_Initial, t.Normal_Auto_Repeat
 		} else {
-			t.Remaining = t.Normal_Manual_Initial
-			t.cycleDuration = t.Normal_Manual_Repeat
+			initial, repeat = t.Normal_Manual_Initial, t.Normal_Manual_Repeat
 		}
+		t.cycleDuration = repeat
+		t.deadline = time.Now().Add(time.Duration(initial) * time.Second)
+	} else {
+		// Starting from StatePaused (e.g. re-pressing the primary tap
+		// while paused) resumes from where the countdown left off.
+		t.deadline = time.Now().Add(t.pausedRemaining)
 	}
 	t.mu.Unlock()
 
-	t.changeState(a, newState, mode)
+	t.changeState(a, trans)
 }
 
-// Pause stops the timer's countdown.
+// Pause stops the timer's countdown, freezing the remaining time so Resume
+// can pick up from the same point.
 func (t *DotaTimer) Pause(a App) {
-	t.mu.RLock()
-	state := t.State
-	t.mu.RUnlock()
-	switch state {
-	case StateActiveManual:
-		t.changeState(a, StatePaused, ModeManual)
-	case StateActiveAuto:
-		t.changeState(a, StatePaused, ModeAuto)
+	t.mu.Lock()
+	trans := t.step(EvPause())
+	if trans == TransNone {
+		t.illegalTransitions++
+	} else {
+		t.pausedRemaining = clampNonNegative(time.Until(t.deadline))
 	}
+	t.mu.Unlock()
+	t.changeState(a, trans)
 }
 
-// Resume continues a paused timer.
+// Resume continues a paused timer from its frozen remaining time.
 func (t *DotaTimer) Resume(a App) {
-	t.mu.RLock()
-	isPaused := t.State == StatePaused
-	mode := t.mode
-	t.mu.RUnlock()
-	if isPaused {
-		switch mode {
-		case ModeManual:
-			t.changeState(a, StateActiveManual, ModeManual)
-		case ModeAuto:
-			t.changeState(a, StateActiveAuto, ModeAuto)
-		}
+	t.mu.Lock()
+	trans := t.step(EvResume())
+	if trans == TransNone {
+		t.illegalTransitions++
+	} else {
+		t.deadline = time.Now().Add(t.pausedRemaining)
 	}
+	t.mu.Unlock()
+	t.changeState(a, trans)
 }
 
 // Reset puts the timer back to its initial state.
 func (t *DotaTimer) Reset(a App) {
 	t.mu.Lock()
+	trans := t.step(EvReset())
 	t.Remaining = 0
 	t.cycleDuration = 0
+	t.pausedRemaining = 0
 	if t.Name == "Custom Timer" {
 		t.CustomDurationSec = 0 // Reset custom duration to 0
-		t.mu.Unlock()
-		t.changeState(a, StateUnconfigured, 0)
-		return
 	}
-	t.mode = ModeAuto
 	t.mu.Unlock()
-	t.changeState(a, StateInactive, t.mode)
+	t.changeState(a, trans)
 }
 
-// Alert plays the timer's sound.
+// Alert plays the timer's sound, fires a desktop notification, and
+// publishes EvAlertFired.
 func (t *DotaTimer) Alert(a App) {
 	a.PlaySound(t.AudioFilename)
+	a.Notify(t)
+	a.PublishEvent(events.Event{
+		Kind:      events.EvAlertFired,
+		Timer:     t.Name,
+		At:        time.Now(),
+		Remaining: t.GetRemaining(),
+	})
+}
+
+// Deadline returns the wall-clock instant this timer is due to expire at.
+// Only meaningful while the timer is active; callers drive a Scheduler
+// entry off it (see ScheduleAt).
+func (t *DotaTimer) Deadline() time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.deadline
 }
 
-// Tick processes one second of time passing.
-func (t *DotaTimer) Tick(a App) {
+// Expire is invoked by a Scheduler exactly when Deadline elapses. It plays
+// the alert and, for repeating timers, recomputes the next deadline from
+// cycleDuration, reporting whether the caller should register a new
+// Scheduler entry for that next deadline.
+func (t *DotaTimer) Expire(a App) (reschedule bool) {
 	t.mu.Lock()
-	t.Remaining--
-	remaining := t.Remaining
-	name := t.Name
-	state := t.State
+	trans := t.step(EvTick())
 	custom := t.CustomDurationSec
 	cycle := t.cycleDuration
 	t.mu.Unlock()
 
-	if remaining <= 0 {
+	switch trans {
+	case TransAlertAndStop:
 		t.Alert(a)
-		if name == "Custom Timer" && state == StateActiveManual {
-			t.mu.Lock()
-			t.Remaining = custom
-			t.mu.Unlock()
-			t.changeState(a, StateInactive, 0)
-		} else {
-			t.mu.Lock()
-			t.Remaining = cycle
-			t.mu.Unlock()
-		}
+		t.mu.Lock()
+		t.Remaining = custom
+		t.mu.Unlock()
+		t.changeState(a, TransToInactive)
+		return false
+	case TransAlertAndRepeat:
+		t.Alert(a)
+		t.mu.Lock()
+		t.deadline = time.Now().Add(time.Duration(cycle) * time.Second)
+		t.mu.Unlock()
+		return true
+	}
+	return false
+}
+
+// remainingLocked computes the live countdown in whole seconds. Callers
+// must hold t.mu.
+func (t *DotaTimer) remainingLocked() int {
+	switch t.State {
+	case StateActiveAuto, StateActiveManual:
+		return int(clampNonNegative(time.Until(t.deadline)).Round(time.Second) / time.Second)
+	case StatePaused:
+		return int(clampNonNegative(t.pausedRemaining).Round(time.Second) / time.Second)
+	default:
+		return t.Remaining
 	}
 }
 
+// remainingMillisLocked is remainingLocked at sub-second resolution, for UI
+// elements (e.g. a progress ring) that want smoother motion than a 1Hz
+// digit change. Callers must hold t.mu.
+func (t *DotaTimer) remainingMillisLocked() int64 {
+	switch t.State {
+	case StateActiveAuto, StateActiveManual:
+		return clampNonNegative(time.Until(t.deadline)).Milliseconds()
+	case StatePaused:
+		return clampNonNegative(t.pausedRemaining).Milliseconds()
+	default:
+		return int64(t.Remaining) * 1000
+	}
+}
+
+// clampNonNegative floors a duration at zero, used when deriving a
+// countdown from a deadline that may have just elapsed.
+func clampNonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
 // GetState returns the current state in a thread-safe manner.
 func (t *DotaTimer) GetState() TimerState {
 	t.mu.RLock()
@@ -220,11 +404,12 @@ func (t *DotaTimer) GetState() TimerState {
 	return t.State
 }
 
-// GetRemaining returns the remaining seconds in a thread-safe manner.
+// GetRemaining returns the remaining seconds, derived from Deadline for an
+// active or paused timer, in a thread-safe manner.
 func (t *DotaTimer) GetRemaining() int {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
-	return t.Remaining
+	return t.remainingLocked()
 }
 
 // GetCustomDurationSec returns the custom duration in a thread-safe manner.
@@ -234,42 +419,55 @@ func (t *DotaTimer) GetCustomDurationSec() int {
 	return t.CustomDurationSec
 }
 
-// SetCustomDuration sets the custom duration and marks the timer as inactive.
-func (t *DotaTimer) SetCustomDuration(val int) {
+// SetCustomDuration sets the custom duration, marks the timer as inactive,
+// and publishes EvCustomDurationSet.
+func (t *DotaTimer) SetCustomDuration(a App, val int) {
 	t.mu.Lock()
 	t.CustomDurationSec = val
 	t.State = StateInactive
 	t.mu.Unlock()
+
+	a.PublishEvent(events.Event{
+		Kind:      events.EvCustomDurationSet,
+		Timer:     t.Name,
+		At:        time.Now(),
+		State:     int(StateInactive),
+		Remaining: val,
+	})
 }
 
 // TimerSnapshot is an atomic snapshot of timer fields that the UI needs to
 // render a consistent view. Call GetSnapshot() to obtain a coherent set of
 // values under the timer lock.
 type TimerSnapshot struct {
-	State             TimerState
-	Remaining         int
-	Mode              TimerMode
-	CustomDurationSec int
-	Name              string
+	State                 TimerState
+	Remaining             int
+	Mode                  TimerMode
+	CustomDurationSec     int
+	Name                  string
 	Normal_Auto_Initial   int
 	Normal_Manual_Initial int
 	Normal_Auto_Repeat    int
 	Normal_Manual_Repeat  int
+	IllegalTransitions    int
+	RemainingMillis       int64
 }
 
 // GetSnapshot returns a consistent snapshot of the timer's state for UI use.
 func (t *DotaTimer) GetSnapshot() TimerSnapshot {
 	t.mu.RLock()
 	snap := TimerSnapshot{
-		State:             t.State,
-		Remaining:         t.Remaining,
-		Mode:              t.mode,
-		CustomDurationSec: t.CustomDurationSec,
-		Name:              t.Name,
+		State:                 t.State,
+		Remaining:             t.remainingLocked(),
+		Mode:                  t.mode,
+		CustomDurationSec:     t.CustomDurationSec,
+		Name:                  t.Name,
 		Normal_Auto_Initial:   t.Normal_Auto_Initial,
 		Normal_Manual_Initial: t.Normal_Manual_Initial,
 		Normal_Auto_Repeat:    t.Normal_Auto_Repeat,
 		Normal_Manual_Repeat:  t.Normal_Manual_Repeat,
+		IllegalTransitions:    t.illegalTransitions,
+		RemainingMillis:       t.remainingMillisLocked(),
 	}
 	t.mu.RUnlock()
 	return snap
@@ -290,8 +488,3 @@ func (t *DotaTimer) SetNormal_Manual_InitialRepeat(initial, repeat int) {
 	t.Normal_Manual_Initial = initial
 	t.Normal_Manual_Repeat = repeat
 }
-
-// GetOriginals returns the original timer configuration values.
-func (t *DotaTimer) GetOriginals() (int, int, int, int, int, int, int, int) {
-	return t.originalNormal_Auto_Initial, t.originalNormal_Auto_Repeat, t.originalTurbo_Auto_Initial, t.originalTurbo_Auto_Repeat, t.originalNormal_Manual_Initial, t.originalNormal_Manual_Repeat, t.originalTurbo_Manual_Initial, t.originalTurbo_Manual_Repeat
-}
\ No newline at end of file
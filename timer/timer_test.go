@@ -0,0 +1,142 @@
+package timer
+
+import (
+	"testing"
+	"time"
+
+	"D2Timers/events"
+)
+
+// fakeApp is a minimal App implementation for exercising changeState
+// without any Fyne or audio dependencies.
+type fakeApp struct {
+	added     []*DotaTimer
+	removed   []*DotaTimer
+	published []events.Event
+}
+
+func (f *fakeApp) AddActiveTimer(t *DotaTimer)    { f.added = append(f.added, t) }
+func (f *fakeApp) RemoveActiveTimer(t *DotaTimer) { f.removed = append(f.removed, t) }
+func (f *fakeApp) PlaySound(string)               {}
+func (f *fakeApp) Notify(*DotaTimer)              {}
+func (f *fakeApp) PublishEvent(ev events.Event)   { f.published = append(f.published, ev) }
+
+// timeNowPlus returns a deadline n seconds from now, for exercising the
+// evTick deadline comparison in step without a real Scheduler.
+func timeNowPlus(n int) time.Time {
+	return time.Now().Add(time.Duration(n) * time.Second)
+}
+
+func newTestTimer(name string) *DotaTimer {
+	return NewDotaTimer(&TimerConfig{
+		Name:                  name,
+		Normal_Auto_Initial:   60,
+		Normal_Auto_Repeat:    60,
+		Normal_Manual_Initial: 30,
+		Normal_Manual_Repeat:  30,
+	})
+}
+
+func TestStepTransitions(t *testing.T) {
+	cases := []struct {
+		name   string
+		setup  func(*DotaTimer)
+		event  Event
+		expect Transition
+	}{
+		{"start auto from inactive", nil, EvStart(ModeAuto), TransToActiveAuto},
+		{"start manual from inactive", nil, EvStart(ModeManual), TransToActiveManual},
+		{"pause while inactive is illegal", nil, EvPause(), TransNone},
+		{"pause while active auto", func(dt *DotaTimer) { dt.State = StateActiveAuto }, EvPause(), TransToPaused},
+		{"resume while not paused is illegal", nil, EvResume(), TransNone},
+		{"resume from paused auto", func(dt *DotaTimer) {
+			dt.State = StatePaused
+			dt.mode = ModeAuto
+		}, EvResume(), TransToActiveAuto},
+		{"resume from paused manual", func(dt *DotaTimer) {
+			dt.State = StatePaused
+			dt.mode = ModeManual
+		}, EvResume(), TransToActiveManual},
+		{"reset goes inactive", func(dt *DotaTimer) { dt.State = StateActiveAuto }, EvReset(), TransToInactive},
+		{"tick before deadline is a no-op", func(dt *DotaTimer) {
+			dt.State = StateActiveAuto
+			dt.deadline = timeNowPlus(10)
+		}, EvTick(), TransNone},
+		{"tick past deadline repeats", func(dt *DotaTimer) {
+			dt.State = StateActiveAuto
+			dt.deadline = timeNowPlus(-1)
+		}, EvTick(), TransAlertAndRepeat},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			dt := newTestTimer("Stack Neutrals")
+			if c.setup != nil {
+				c.setup(dt)
+			}
+			if got := dt.step(c.event); got != c.expect {
+				t.Errorf("step(%+v) = %v, want %v", c.event, got, c.expect)
+			}
+		})
+	}
+}
+
+func TestStepCustomTimerRules(t *testing.T) {
+	dt := newTestTimer("Custom Timer")
+
+	if got := dt.step(EvStart(ModeAuto)); got != TransNone {
+		t.Errorf("starting a custom timer with no duration set should be illegal, got %v", got)
+	}
+
+	dt.CustomDurationSec = 45
+	if got := dt.step(EvStart(ModeAuto)); got != TransToActiveAuto {
+		t.Errorf("starting a configured custom timer should succeed, got %v", got)
+	}
+
+	if got := dt.step(EvReset()); got != TransToUnconfigured {
+		t.Errorf("resetting a custom timer should return it to unconfigured, got %v", got)
+	}
+}
+
+func TestChangeStateAppliesAndPublishes(t *testing.T) {
+	dt := newTestTimer("Stack Neutrals")
+	app := &fakeApp{}
+
+	dt.changeState(app, TransToActiveAuto)
+
+	if dt.GetState() != StateActiveAuto {
+		t.Fatalf("State = %v, want StateActiveAuto", dt.GetState())
+	}
+	if dt.GetMode() != ModeAuto {
+		t.Fatalf("mode = %v, want ModeAuto", dt.GetMode())
+	}
+	if len(app.added) != 1 || app.added[0] != dt {
+		t.Fatalf("expected AddActiveTimer(dt) to be called once, got %v", app.added)
+	}
+	if len(app.published) != 1 || app.published[0].Kind != events.EvStateChanged {
+		t.Fatalf("expected one EvStateChanged event, got %v", app.published)
+	}
+
+	dt.changeState(app, TransToInactive)
+
+	if dt.GetState() != StateInactive {
+		t.Fatalf("State = %v, want StateInactive", dt.GetState())
+	}
+	if len(app.removed) != 1 || app.removed[0] != dt {
+		t.Fatalf("expected RemoveActiveTimer(dt) to be called once, got %v", app.removed)
+	}
+}
+
+func TestChangeStateNoneIsIgnored(t *testing.T) {
+	dt := newTestTimer("Stack Neutrals")
+	app := &fakeApp{}
+
+	dt.changeState(app, TransNone)
+
+	if dt.GetState() != StateInactive {
+		t.Fatalf("State = %v, want unchanged StateInactive", dt.GetState())
+	}
+	if len(app.published) != 0 {
+		t.Fatalf("TransNone should not publish an event, got %v", app.published)
+	}
+}
@@ -0,0 +1,284 @@
+package ui
+
+import (
+	"image/color"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/widget"
+)
+
+// DefaultHoldDuration is how long a destructive gesture must be held before
+// HoldToConfirm fires OnConfirm.
+const DefaultHoldDuration = 800 * time.Millisecond
+
+const holdTickRate = time.Second / 30
+
+// HoldToConfirm wraps a target CanvasObject (typically a TappableContainer
+// or widget.Button) so that the mouse-button hold path it mediates (the
+// secondary button by default, the primary one for NewHoldToConfirmButton;
+// see Button) only fires OnConfirm after being held continuously for
+// Duration. A translucent
+// loader rectangle is drawn on top of the target and grows with progress;
+// releasing early cancels and animates the loader back to zero. Shift+click
+// bypasses the hold entirely for users who trust their own aim.
+//
+// target renders as a genuine descendant of h so it still looks and lays
+// out correctly, but that means Fyne's hit test would otherwise resolve
+// clicks straight to target (it satisfies the same Tappable/Mouseable
+// interfaces h does) instead of reaching h itself. A transparent gate is
+// stacked on top of target, last, so it wins the hit test instead and
+// forwards every event back to h's own handlers.
+type HoldToConfirm struct {
+	widget.BaseWidget
+
+	target    fyne.CanvasObject
+	Duration  time.Duration
+	OnConfirm func()
+	// Button selects which mouse button drives the hold gesture. Secondary
+	// (the default) suits TappableContainer's right-click reset path;
+	// widget.Button wrappers use MouseButtonPrimary since buttons only ever
+	// see left clicks.
+	Button desktop.MouseButton
+
+	mu        sync.Mutex
+	startedAt time.Time
+	ticker    *time.Ticker
+	stopHold  chan struct{}
+	progress  float64
+
+	loader *canvas.Rectangle
+	gate   *holdGate
+}
+
+// NewHoldToConfirm creates a HoldToConfirm wrapping target, firing onConfirm
+// once the hold completes.
+func NewHoldToConfirm(target fyne.CanvasObject, onConfirm func()) *HoldToConfirm {
+	h := &HoldToConfirm{
+		target:    target,
+		Duration:  DefaultHoldDuration,
+		OnConfirm: onConfirm,
+		Button:    desktop.MouseButtonSecondary,
+		loader:    canvas.NewRectangle(color.Transparent),
+	}
+	h.gate = newHoldGate(h)
+	h.ExtendBaseWidget(h)
+	return h
+}
+
+// NewHoldToConfirmButton wraps a widget.Button whose own OnTapped has been
+// cleared by the caller, so that left-button holds (rather than an instant
+// click) drive onConfirm.
+func NewHoldToConfirmButton(btn *widget.Button, onConfirm func()) *HoldToConfirm {
+	h := NewHoldToConfirm(btn, onConfirm)
+	h.Button = desktop.MouseButtonPrimary
+	return h
+}
+
+// SetHoldDuration overrides the default hold duration.
+func (h *HoldToConfirm) SetHoldDuration(d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.Duration = d
+}
+
+func (h *HoldToConfirm) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(container.NewStack(h.target, h.loader, h.gate))
+}
+
+// Tapped forwards the tap straight through to the wrapped target, unless
+// this HoldToConfirm is itself gating primary clicks (the widget.Button
+// case), in which case the hold path is the only way to fire OnConfirm.
+func (h *HoldToConfirm) Tapped(e *fyne.PointEvent) {
+	if h.Button == desktop.MouseButtonPrimary {
+		return
+	}
+	if t, ok := h.target.(fyne.Tappable); ok {
+		t.Tapped(e)
+	}
+}
+
+// TappedSecondary forwards a secondary-button tap straight through to the
+// wrapped target, unless this HoldToConfirm is itself gating secondary
+// clicks (the TappableContainer reset case), in which case the hold path
+// is the only way to fire OnConfirm.
+func (h *HoldToConfirm) TappedSecondary(e *fyne.PointEvent) {
+	if h.Button == desktop.MouseButtonSecondary {
+		return
+	}
+	if t, ok := h.target.(fyne.SecondaryTappable); ok {
+		t.TappedSecondary(e)
+	}
+}
+
+// MouseDown starts (or, with Shift held, immediately confirms) the hold
+// gesture when the gated mouse button is pressed.
+func (h *HoldToConfirm) MouseDown(e *desktop.MouseEvent) {
+	if e.Button != h.Button {
+		return
+	}
+	if btn, ok := h.target.(*widget.Button); ok && btn.Disabled() {
+		return
+	}
+	if e.Modifier&fyne.KeyModifierShift != 0 {
+		h.fire()
+		return
+	}
+	h.startHold()
+}
+
+// MouseUp cancels an in-progress hold before it completes.
+func (h *HoldToConfirm) MouseUp(e *desktop.MouseEvent) {
+	if e.Button != h.Button {
+		return
+	}
+	h.cancelHold()
+}
+
+func (h *HoldToConfirm) startHold() {
+	h.mu.Lock()
+	if h.ticker != nil {
+		h.mu.Unlock()
+		return
+	}
+	h.startedAt = time.Now()
+	h.ticker = time.NewTicker(holdTickRate)
+	stop := make(chan struct{})
+	h.stopHold = stop
+	ticker := h.ticker
+	h.mu.Unlock()
+
+	go func() {
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if h.onTick() {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// onTick advances progress and applies it to the loader; it returns true
+// once the hold has completed (and fired OnConfirm), ending the goroutine.
+func (h *HoldToConfirm) onTick() bool {
+	h.mu.Lock()
+	elapsed := time.Since(h.startedAt)
+	progress := float64(elapsed) / float64(h.Duration)
+	if progress > 1.0 {
+		progress = 1.0
+	}
+	h.progress = progress
+	h.mu.Unlock()
+
+	h.updateLoader(progress)
+
+	if progress >= 1.0 {
+		h.fire()
+		return true
+	}
+	return false
+}
+
+func (h *HoldToConfirm) cancelHold() {
+	h.mu.Lock()
+	holding := h.ticker != nil
+	if holding {
+		h.ticker.Stop()
+		h.ticker = nil
+		close(h.stopHold)
+		h.stopHold = nil
+	}
+	h.mu.Unlock()
+
+	if holding {
+		h.animateReset()
+	}
+}
+
+func (h *HoldToConfirm) animateReset() {
+	go func() {
+		ticker := time.NewTicker(holdTickRate)
+		defer ticker.Stop()
+		for range ticker.C {
+			h.mu.Lock()
+			h.progress -= 0.12
+			if h.progress < 0 {
+				h.progress = 0
+			}
+			progress := h.progress
+			h.mu.Unlock()
+
+			h.updateLoader(progress)
+			if progress <= 0 {
+				return
+			}
+		}
+	}()
+}
+
+// Confirm immediately fires OnConfirm, bypassing the hold gesture
+// entirely. Used by keyboard shortcuts that trigger the same destructive
+// action as a held click (e.g. AppManager.ResetAll's keybinding).
+func (h *HoldToConfirm) Confirm() {
+	h.fire()
+}
+
+func (h *HoldToConfirm) fire() {
+	h.mu.Lock()
+	if h.ticker != nil {
+		h.ticker.Stop()
+		h.ticker = nil
+		close(h.stopHold)
+		h.stopHold = nil
+	}
+	h.progress = 0
+	h.mu.Unlock()
+
+	h.updateLoader(0)
+
+	if h.OnConfirm != nil {
+		h.OnConfirm()
+	}
+}
+
+func (h *HoldToConfirm) updateLoader(progress float64) {
+	size := h.Size()
+	fyne.Do(func() {
+		h.loader.FillColor = withAlpha(color.White, uint8(progress*110))
+		h.loader.Resize(fyne.NewSize(size.Width*float32(progress), size.Height))
+		h.loader.Refresh()
+	})
+}
+
+// holdGate is an invisible widget stacked on top of h.target so it is the
+// last (innermost) object Fyne's hit test matches, winning over target's
+// own Tappable/SecondaryTappable/Mouseable methods. Every event it
+// receives is forwarded straight back to h, so behavior is exactly as if
+// h had been hit-tested directly.
+type holdGate struct {
+	widget.BaseWidget
+	h *HoldToConfirm
+}
+
+func newHoldGate(h *HoldToConfirm) *holdGate {
+	g := &holdGate{h: h}
+	g.ExtendBaseWidget(g)
+	return g
+}
+
+func (g *holdGate) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(canvas.NewRectangle(color.Transparent))
+}
+
+func (g *holdGate) Tapped(e *fyne.PointEvent)          { g.h.Tapped(e) }
+func (g *holdGate) TappedSecondary(e *fyne.PointEvent) { g.h.TappedSecondary(e) }
+func (g *holdGate) MouseDown(e *desktop.MouseEvent)    { g.h.MouseDown(e) }
+func (g *holdGate) MouseUp(e *desktop.MouseEvent)      { g.h.MouseUp(e) }
@@ -0,0 +1,169 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/widget"
+)
+
+// profilingEnabled gates all frame-stats overhead: recordFrame only
+// samples time.Now() when this is set, so the cost when the overlay is off
+// is a single atomic load per UpdateDisplay call.
+var profilingEnabled atomic.Bool
+
+// frameSample is one recorded interval between consecutive UpdateDisplay
+// calls across all TimerWidgets, timestamped so stale samples can be
+// dropped from the rolling window.
+type frameSample struct {
+	at       time.Time
+	interval time.Duration
+}
+
+const frameStatsWindow = 5 * time.Second
+
+var frameStats struct {
+	mu      sync.Mutex
+	last    time.Time
+	samples []frameSample
+}
+
+// recordFrame is called from TimerWidget.UpdateDisplay to feed the
+// profiling overlay's FPS/max/p99 figures. It is a no-op unless profiling
+// is enabled.
+func recordFrame() {
+	if !profilingEnabled.Load() {
+		return
+	}
+	now := time.Now()
+
+	frameStats.mu.Lock()
+	defer frameStats.mu.Unlock()
+
+	if !frameStats.last.IsZero() {
+		frameStats.samples = append(frameStats.samples, frameSample{at: now, interval: now.Sub(frameStats.last)})
+	}
+	frameStats.last = now
+
+	cutoff := now.Add(-frameStatsWindow)
+	kept := frameStats.samples[:0]
+	for _, s := range frameStats.samples {
+		if s.at.After(cutoff) {
+			kept = append(kept, s)
+		}
+	}
+	frameStats.samples = kept
+}
+
+// snapshotFrameStats reports FPS, the slowest interval, and the p99
+// interval over the last frameStatsWindow of recorded frames.
+func snapshotFrameStats() (fps float64, maxInterval, p99 time.Duration, sampleCount int) {
+	frameStats.mu.Lock()
+	samples := append([]frameSample(nil), frameStats.samples...)
+	frameStats.mu.Unlock()
+
+	sampleCount = len(samples)
+	if sampleCount == 0 {
+		return 0, 0, 0, 0
+	}
+
+	intervals := make([]time.Duration, sampleCount)
+	var total time.Duration
+	for i, s := range samples {
+		intervals[i] = s.interval
+		total += s.interval
+		if s.interval > maxInterval {
+			maxInterval = s.interval
+		}
+	}
+	if total > 0 {
+		fps = float64(sampleCount) / total.Seconds()
+	}
+
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i] < intervals[j] })
+	idx := int(float64(len(intervals)) * 0.99)
+	if idx >= len(intervals) {
+		idx = len(intervals) - 1
+	}
+	p99 = intervals[idx]
+	return
+}
+
+// ProfilingOverlay is a small top-right panel reporting FPS, frame-interval
+// max/p99 over the last 5 seconds, active timer count, and goroutine
+// count. It's toggled by AppManager.ToggleProfiling (the `~` key) and costs
+// nothing while hidden: profilingEnabled stays false until shown.
+type ProfilingOverlay struct {
+	widget.BaseWidget
+
+	label          *widget.Label
+	getActiveCount func() int
+}
+
+// NewProfilingOverlay creates a hidden overlay; getActiveCount supplies the
+// active-timer count shown alongside the frame-time figures.
+func NewProfilingOverlay(getActiveCount func() int) *ProfilingOverlay {
+	o := &ProfilingOverlay{
+		label:          widget.NewLabel(""),
+		getActiveCount: getActiveCount,
+	}
+	o.ExtendBaseWidget(o)
+	o.Hide()
+	return o
+}
+
+func (o *ProfilingOverlay) CreateRenderer() fyne.WidgetRenderer {
+	bg := canvas.NewRectangle(color.NRGBA{R: 0, G: 0, B: 0, A: 190})
+	panel := container.New(layout.NewStackLayout(), bg, container.New(layout.NewPaddedLayout(), o.label))
+
+	row := container.New(layout.NewBorderLayout(nil, nil, nil, panel), layout.NewSpacer(), panel)
+	return widget.NewSimpleRenderer(row)
+}
+
+// Refresh updates the displayed figures before repainting. Call it on a
+// low-frequency cadence (e.g. once a second) while the overlay is visible;
+// there's no need to refresh it while hidden.
+func (o *ProfilingOverlay) Refresh() {
+	fps, maxInterval, p99, n := snapshotFrameStats()
+	active := 0
+	if o.getActiveCount != nil {
+		active = o.getActiveCount()
+	}
+	o.label.SetText(fmt.Sprintf(
+		"FPS: %.1f\nmax: %s  p99: %s (n=%d)\nactive timers: %d\ngoroutines: %d",
+		fps, maxInterval.Round(time.Millisecond), p99.Round(time.Millisecond), n,
+		active, runtime.NumGoroutine(),
+	))
+	o.BaseWidget.Refresh()
+}
+
+// SetProfilingEnabled toggles frame-stats collection. Disabling it also
+// drops any accumulated samples, so re-enabling starts from a clean
+// 5-second window.
+func (o *ProfilingOverlay) SetProfilingEnabled(enabled bool) {
+	profilingEnabled.Store(enabled)
+	if enabled {
+		o.Show()
+		return
+	}
+	o.Hide()
+	frameStats.mu.Lock()
+	frameStats.samples = nil
+	frameStats.last = time.Time{}
+	frameStats.mu.Unlock()
+}
+
+// ProfilingEnabled reports whether the overlay is currently collecting and
+// showing frame stats.
+func (o *ProfilingOverlay) ProfilingEnabled() bool {
+	return profilingEnabled.Load()
+}
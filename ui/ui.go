@@ -23,17 +23,28 @@ type App interface {
 	timer.App
 	AllTimers() []*timer.DotaTimer
 	UpdateControlButtonState()
-	HandleKeyRune(rune)
+	BindKeymap(w fyne.Window)
 	ShowInfoDialog(title, contentFile string, minSize fyne.Size)
+	ShowSettingsDialog()
+	ShowHistoryDialog()
+	ShowKeybindingsDialog()
 	CreateBackgroundImage(string) fyne.CanvasObject
 	SetAutoButton(*widget.Button)
 	SetStartButton(*widget.Button)
 	SetStopButton(*widget.Button)
 	SetResetButton(*widget.Button)
+	SetResetHold(*HoldToConfirm)
 	EnqueueCommand(cmd control.Command)
-	ToggleTurboMode(enable bool) error
-	IsTurboEnabled() bool
-	SetTurboCheck(*widget.Check)
+	AvailableProfileNames() []string
+	ActiveProfileName() string
+	SetActiveProfile(name string) error
+	SetProfileSelect(*widget.Select)
+	ShowSaveProfileDialog()
+	IsNotifyEnabled() bool
+	SetNotifyEnabled(bool)
+	SetNotifyCheck(*widget.Check)
+	SetProfilingOverlay(*ProfilingOverlay)
+	ActiveTimerCount() int
 }
 
 type TimerWidget struct {
@@ -45,6 +56,7 @@ type TimerWidget struct {
 	colorFilterRect        *canvas.Rectangle
 	borderRect             *canvas.Rectangle
 	tappableContainer      *TappableContainer
+	resetHold              *HoldToConfirm
 	customContentContainer *fyne.Container
 	customInputContainer   *fyne.Container
 	customTimeEntry        *widget.Entry
@@ -90,7 +102,7 @@ func NewTimerWidget(a App, t *timer.DotaTimer) *TimerWidget {
 			if err != nil {
 				return
 			}
-			t.SetCustomDuration(val)
+			t.SetCustomDuration(a, val)
 
 			w.customInputContainer.Hide()
 			w.customContentContainer.Show()
@@ -154,7 +166,7 @@ func NewTimerWidget(a App, t *timer.DotaTimer) *TimerWidget {
 		a.UpdateControlButtonState()
 	}
 
-	w.tappableContainer.OnTappedSecondary = func(e *fyne.PointEvent) {
+	w.resetHold = NewHoldToConfirm(w.tappableContainer, func() {
 		reply := make(chan error, 1)
 		a.EnqueueCommand(control.Command{Type: control.CmdReset, Target: t, Reply: reply})
 		select {
@@ -169,14 +181,14 @@ func NewTimerWidget(a App, t *timer.DotaTimer) *TimerWidget {
 			t.UI.UpdateDisplay()
 		}
 		a.UpdateControlButtonState()
-	}
+	})
 
 	w.UpdateDisplay()
 	return w
 }
 
 func (tw *TimerWidget) GetCanvasObject() fyne.CanvasObject {
-	return tw.tappableContainer
+	return tw.resetHold
 }
 
 func parseTime(input string) (int, error) {
@@ -228,6 +240,7 @@ func (tw *TimerWidget) getTimeDisplayStringFromSnapshot(s timer.TimerSnapshot) s
 }
 
 func (tw *TimerWidget) UpdateDisplay() {
+	recordFrame()
 	s := tw.DotaTimer.GetSnapshot()
 	fyne.Do(func() {
 		var opacity float64 = 0.65
@@ -267,7 +280,7 @@ func BuildTimersList(a App) *fyne.Container {
 	return listContainer
 }
 
-func BuildFooter(a App, w fyne.Window) (*widget.Button, *widget.Button, *widget.Button, *widget.Button, fyne.CanvasObject) {
+func BuildFooter(a App, w fyne.Window) (*widget.Button, *widget.Button, *widget.Button, *widget.Button, *HoldToConfirm, fyne.CanvasObject) {
 	autoButton := widget.NewButton("Auto", func() {
 		var replies []chan error
 		for _, t := range a.AllTimers() {
@@ -333,7 +346,8 @@ func BuildFooter(a App, w fyne.Window) (*widget.Button, *widget.Button, *widget.
 	})
 	startButton.Hide()
 
-	resetButton := widget.NewButton(i18n.T("Reset"), func() {
+	resetButton := widget.NewButton(i18n.T("Reset"), nil)
+	resetHold := NewHoldToConfirmButton(resetButton, func() {
 		var replies []chan error
 		for _, t := range a.AllTimers() {
 			reply := make(chan error, 1)
@@ -364,30 +378,61 @@ func BuildFooter(a App, w fyne.Window) (*widget.Button, *widget.Button, *widget.
 		a.ShowInfoDialog(i18n.T("Help"), "assets/timers_help.yaml", fyne.NewSize(500, 400))
 	}, nil)
 
+	settingsIcon := widget.NewIcon(theme.SettingsIcon())
+	settingsButton := NewTappableContainer(settingsIcon, func() {
+		a.ShowSettingsDialog()
+	}, nil)
+
+	historyIcon := widget.NewIcon(theme.HistoryIcon())
+	historyButton := NewTappableContainer(historyIcon, func() {
+		a.ShowHistoryDialog()
+	}, nil)
+
+	keybindingsIcon := widget.NewIcon(theme.MenuIcon())
+	keybindingsButton := NewTappableContainer(keybindingsIcon, func() {
+		a.ShowKeybindingsDialog()
+	}, nil)
+
+	saveProfileIcon := widget.NewIcon(theme.DocumentSaveIcon())
+	saveProfileButton := NewTappableContainer(saveProfileIcon, func() {
+		a.ShowSaveProfileDialog()
+	}, nil)
+
 	leftContent := container.NewVBox(
 		layout.NewSpacer(),
 		helpButton,
+		settingsButton,
+		historyButton,
+		keybindingsButton,
+		saveProfileButton,
 	)
 
-	turboCheck := widget.NewCheck(i18n.T("Turbo"), nil)
-	if a.IsTurboEnabled() {
-		turboCheck.SetChecked(true)
-	}
-	turboCheck.OnChanged = func(checked bool) {
-		if err := a.ToggleTurboMode(checked); err != nil {
+	profileSelect := widget.NewSelect(a.AvailableProfileNames(), nil)
+	profileSelect.SetSelected(a.ActiveProfileName())
+	profileSelect.OnChanged = func(selected string) {
+		if err := a.SetActiveProfile(selected); err != nil {
 			fyne.Do(func() {
-				turboCheck.SetChecked(!checked)
+				profileSelect.SetSelected(a.ActiveProfileName())
 			})
 		}
 		w.Canvas().Focus(nil)
 	}
-	a.SetTurboCheck(turboCheck)
+	a.SetProfileSelect(profileSelect)
 
-	controlButtons := container.NewHBox(controlStack, buttonsSpacer, resetButton)
+	notifyCheck := widget.NewCheck(i18n.T("Notifications"), nil)
+	notifyCheck.SetChecked(a.IsNotifyEnabled())
+	notifyCheck.OnChanged = func(checked bool) {
+		a.SetNotifyEnabled(checked)
+		w.Canvas().Focus(nil)
+	}
+	a.SetNotifyCheck(notifyCheck)
+
+	controlButtons := container.NewHBox(controlStack, buttonsSpacer, resetHold)
 
 	centeredCheckbox := container.NewHBox(
 		layout.NewSpacer(),
-		turboCheck,
+		profileSelect,
+		notifyCheck,
 		layout.NewSpacer(),
 	)
 
@@ -414,7 +459,7 @@ func BuildFooter(a App, w fyne.Window) (*widget.Button, *widget.Button, *widget.
 		centeredCentralContentBlock,
 	)
 
-	return autoButton, startButton, stopButton, resetButton, footer
+	return autoButton, startButton, stopButton, resetButton, resetHold, footer
 }
 
 func CreateMainWindow(a App, fyneApp fyne.App, content embed.FS) fyne.Window {
@@ -425,14 +470,15 @@ func CreateMainWindow(a App, fyneApp fyne.App, content embed.FS) fyne.Window {
 	w := fyneApp.NewWindow(title)
 
 	listContainer := BuildTimersList(a)
-	autoButton, startButton, stopButton, resetButton, footerLayout := BuildFooter(a, w)
+	autoButton, startButton, stopButton, resetButton, resetHold, footerLayout := BuildFooter(a, w)
 
 	a.SetAutoButton(autoButton)
 	a.SetStartButton(startButton)
 	a.SetStopButton(stopButton)
 	a.SetResetButton(resetButton)
+	a.SetResetHold(resetHold)
 
-	w.Canvas().SetOnTypedRune(a.HandleKeyRune)
+	a.BindKeymap(w)
 
 	bottomSpacer := canvas.NewRectangle(color.Transparent)
 	bottomSpacer.SetMinSize(fyne.NewSize(0, timer.GapButton))
@@ -443,9 +489,12 @@ func CreateMainWindow(a App, fyneApp fyne.App, content embed.FS) fyne.Window {
 		footerLayout,
 	)
 
+	overlay := NewProfilingOverlay(a.ActiveTimerCount)
+	a.SetProfilingOverlay(overlay)
+
 	a.UpdateControlButtonState()
 
-	w.SetContent(contentVBox)
+	w.SetContent(container.NewStack(contentVBox, overlay))
 	w.Resize(fyne.NewSize(timer.TimerWidth, 469))
 	w.SetFixedSize(true)
 	return w